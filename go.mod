@@ -9,6 +9,7 @@ require (
 	github.com/bytedance/sonic v1.14.1
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.7.6
+	github.com/ledongthuc/pdf v0.0.0-20250511090121-5959a4027728
 	github.com/minio/minio-go/v7 v7.0.95
 	github.com/pgvector/pgvector-go v0.3.0
 	github.com/redis/rueidis v1.0.64
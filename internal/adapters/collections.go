@@ -0,0 +1,179 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	createCollectionsTableTemplate = `
+	CREATE TABLE IF NOT EXISTS %s (
+		id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+		name TEXT NOT NULL UNIQUE,
+		description TEXT NOT NULL DEFAULT '',
+		settings JSONB NOT NULL DEFAULT '{}',
+		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+	);`
+
+	// collection_id 为空的文档不属于任何集合；删除集合时级联删除其文档和分块
+	addDocumentsCollectionTemplate = `
+	ALTER TABLE %s ADD COLUMN IF NOT EXISTS collection_id UUID REFERENCES %s(id) ON DELETE CASCADE;`
+
+	createDocumentsCollectionIndexTemplate = `
+	CREATE INDEX IF NOT EXISTS idx_documents_collection_%s ON %s (collection_id, created_at);`
+
+	collectionColumnsTemplate = `c.id, c.name, c.description, c.settings, c.created_at,
+		(SELECT COUNT(*) FROM %s d WHERE d.collection_id = c.id)`
+)
+
+var (
+	// ErrCollectionNotFound 表示集合不存在
+	ErrCollectionNotFound = errors.New("collection not found")
+	// ErrCollectionExists 表示同名集合已存在
+	ErrCollectionExists = errors.New("collection already exists")
+)
+
+// CollectionSettings 是集合级别的配置覆盖，零值字段使用服务端配置
+type CollectionSettings struct {
+	MaxChunkSize int `json:"max_chunk_size,omitempty"`
+	MinChunkSize int `json:"min_chunk_size,omitempty"`
+	OverlapSize  int `json:"overlap_size,omitempty"`
+	// EmbeddingModel 必须输出与向量表相同的维度
+	EmbeddingModel string `json:"embedding_model,omitempty"`
+	// SystemPrompt 替换上下文总结的系统提示词
+	SystemPrompt string `json:"system_prompt,omitempty"`
+}
+
+// Collection 表示一个知识库集合
+type Collection struct {
+	ID            string             `json:"id"`
+	Name          string             `json:"name"`
+	Description   string             `json:"description"`
+	Settings      CollectionSettings `json:"settings"`
+	CreatedAt     time.Time          `json:"created_at"`
+	DocumentCount int64              `json:"document_count"`
+}
+
+// CreateCollection 创建集合，名称重复时返回 ErrCollectionExists
+func (db *PostgresVectorDB) CreateCollection(ctx context.Context, name, description string, settings CollectionSettings) (*Collection, error) {
+	t := db.tables()
+	settingsJSON, err := json.Marshal(settings)
+	if err != nil {
+		return nil, fmt.Errorf("序列化集合配置失败: %w", err)
+	}
+
+	collection := &Collection{
+		ID:          uuid.New().String(),
+		Name:        name,
+		Description: description,
+		Settings:    settings,
+	}
+	err = db.pool.QueryRow(ctx,
+		fmt.Sprintf(`INSERT INTO %s (id, name, description, settings) VALUES ($1, $2, $3, $4) RETURNING created_at`,
+			t.CollectionsTable()),
+		collection.ID, name, description, settingsJSON).Scan(&collection.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, fmt.Errorf("%w: %s", ErrCollectionExists, name)
+		}
+		return nil, fmt.Errorf("创建集合失败: %w", err)
+	}
+	return collection, nil
+}
+
+// GetCollection 按 ID 获取集合
+func (db *PostgresVectorDB) GetCollection(ctx context.Context, id string) (*Collection, error) {
+	t := db.tables()
+	if uuid.Validate(id) != nil {
+		return nil, ErrCollectionNotFound
+	}
+	row := db.pool.QueryRow(ctx,
+		fmt.Sprintf(`SELECT `+collectionColumnsTemplate+` FROM %s c WHERE c.id = $1`,
+			t.DocumentsTable(), t.CollectionsTable()),
+		id)
+	collection, err := scanCollection(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCollectionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询集合失败: %w", err)
+	}
+	return collection, nil
+}
+
+// ListCollections 按名称列出全部集合
+func (db *PostgresVectorDB) ListCollections(ctx context.Context) ([]Collection, error) {
+	t := db.tables()
+	rows, err := db.pool.Query(ctx,
+		fmt.Sprintf(`SELECT `+collectionColumnsTemplate+` FROM %s c ORDER BY c.name`,
+			t.DocumentsTable(), t.CollectionsTable()))
+	if err != nil {
+		return nil, fmt.Errorf("查询集合列表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var collections []Collection
+	for rows.Next() {
+		collection, err := scanCollection(rows)
+		if err != nil {
+			logger.Get().Error("扫描集合行失败", "error", err)
+			continue
+		}
+		collections = append(collections, *collection)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("遍历集合行失败: %w", err)
+	}
+	return collections, nil
+}
+
+// DeleteCollection 删除集合及其全部文档和分块
+func (db *PostgresVectorDB) DeleteCollection(ctx context.Context, id string) error {
+	t := db.tables()
+	if uuid.Validate(id) != nil {
+		return ErrCollectionNotFound
+	}
+	cmdTag, err := db.pool.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, t.CollectionsTable()), id)
+	if err != nil {
+		return fmt.Errorf("删除集合失败: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return ErrCollectionNotFound
+	}
+	return nil
+}
+
+func scanCollection(row pgx.Row) (*Collection, error) {
+	var (
+		collection   Collection
+		settingsJSON []byte
+	)
+	err := row.Scan(&collection.ID, &collection.Name, &collection.Description, &settingsJSON,
+		&collection.CreatedAt, &collection.DocumentCount)
+	if err != nil {
+		return nil, err
+	}
+	if len(settingsJSON) > 0 {
+		if err := json.Unmarshal(settingsJSON, &collection.Settings); err != nil {
+			logger.Get().Error("解析集合配置失败", "error", err)
+		}
+	}
+	return &collection, nil
+}
+
+// nullableUUID 把空字符串映射为 SQL NULL，用于可选的 collection_id
+func nullableUUID(id string) interface{} {
+	if id == "" {
+		return nil
+	}
+	return id
+}
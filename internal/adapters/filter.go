@@ -0,0 +1,191 @@
+package adapters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidFilter 表示检索过滤条件无法编译为 SQL
+var ErrInvalidFilter = errors.New("invalid search filter")
+
+// MetadataScope 指定元数据条件作用的表
+type MetadataScope string
+
+const (
+	// MetadataScopeDocument 匹配文档表的 metadata
+	MetadataScopeDocument MetadataScope = "document"
+	// MetadataScopeChunk 匹配分块表的 metadata
+	MetadataScopeChunk MetadataScope = "chunk"
+)
+
+// MetadataOp 是元数据条件的比较运算符
+type MetadataOp string
+
+const (
+	MetadataOpEq       MetadataOp = "eq"
+	MetadataOpNe       MetadataOp = "ne"
+	MetadataOpIn       MetadataOp = "in"
+	MetadataOpContains MetadataOp = "contains"
+	MetadataOpExists   MetadataOp = "exists"
+	MetadataOpGt       MetadataOp = "gt"
+	MetadataOpGte      MetadataOp = "gte"
+	MetadataOpLt       MetadataOp = "lt"
+	MetadataOpLte      MetadataOp = "lte"
+)
+
+// 比较运算符按 jsonb 排序规则比较，类型不同的值不会报错，只是不满足条件
+var metadataComparisons = map[MetadataOp]string{
+	MetadataOpEq:  "=",
+	MetadataOpNe:  "<>",
+	MetadataOpGt:  ">",
+	MetadataOpGte: ">=",
+	MetadataOpLt:  "<",
+	MetadataOpLte: "<=",
+}
+
+// MetadataCondition 是作用于 JSONB metadata 的单个条件
+type MetadataCondition struct {
+	Scope MetadataScope
+	// Path 是 metadata 中的键路径，如 ["source", "lang"]
+	Path []string
+	Op   MetadataOp
+	// Values 是 JSON 编码的比较值；in 可有多个，exists 不需要，其余恰好一个
+	Values []json.RawMessage
+}
+
+// SearchFilter 限定检索范围，各条件之间是“与”关系，零值表示不过滤。
+// 条件被编译进检索 SQL 的 WHERE 子句，在近似最近邻查询内部生效。
+type SearchFilter struct {
+	// CollectionID 限定集合，为空时不限
+	CollectionID string
+	// DocumentIDs 限定文档 ID
+	DocumentIDs []string
+	// Tags 要求文档 metadata.tags 数组包含全部标签
+	Tags []string
+	// Uploader 要求文档 metadata.uploader 等于该值
+	Uploader string
+	// CreatedAfter 和 CreatedBefore 限定文档创建时间，含边界
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// Metadata 是任意元数据条件
+	Metadata []MetadataCondition
+	// EmbeddingModel 限定由该向量模型生成的文档（metadata.embedding_model），
+	// 避免比较不同模型的向量。未记录模型的早期文档视为由表集模型生成，总是匹配
+	EmbeddingModel string
+}
+
+// IsZero 报告过滤条件是否为空
+func (f SearchFilter) IsZero() bool {
+	return f.CollectionID == "" && len(f.DocumentIDs) == 0 && len(f.Tags) == 0 && f.Uploader == "" &&
+		f.CreatedAfter.IsZero() && f.CreatedBefore.IsZero() && len(f.Metadata) == 0 && f.EmbeddingModel == ""
+}
+
+// Validate 检查过滤条件能否编译为 SQL
+func (f SearchFilter) Validate() error {
+	_, _, err := f.whereClause(1)
+	return err
+}
+
+// whereClause 把过滤条件编译为以 " AND " 开头的 SQL 片段，c 和 d 分别是
+// 分块表和文档表的别名。所有用户输入都作为参数传递，占位符从 $firstArg 开始编号；
+// SQL 文本只来自固定的运算符模板。
+func (f SearchFilter) whereClause(firstArg int) (string, []interface{}, error) {
+	var (
+		conds []string
+		args  []interface{}
+	)
+	param := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", firstArg+len(args)-1)
+	}
+
+	if f.CollectionID != "" {
+		conds = append(conds, "d.collection_id = "+param(f.CollectionID)+"::uuid")
+	}
+	if len(f.DocumentIDs) > 0 {
+		conds = append(conds, "c.document_id = ANY("+param(f.DocumentIDs)+"::uuid[])")
+	}
+	if len(f.Tags) > 0 {
+		conds = append(conds, "d.metadata -> 'tags' ?& "+param(f.Tags)+"::text[]")
+	}
+	if f.Uploader != "" {
+		conds = append(conds, "d.metadata ->> 'uploader' = "+param(f.Uploader))
+	}
+	if !f.CreatedAfter.IsZero() {
+		conds = append(conds, "d.created_at >= "+param(f.CreatedAfter))
+	}
+	if !f.CreatedBefore.IsZero() {
+		conds = append(conds, "d.created_at <= "+param(f.CreatedBefore))
+	}
+	if f.EmbeddingModel != "" {
+		model := param(f.EmbeddingModel)
+		conds = append(conds, "COALESCE(d.metadata ->> 'embedding_model', "+model+") = "+model)
+	}
+
+	for i, cond := range f.Metadata {
+		var column string
+		switch cond.Scope {
+		case MetadataScopeDocument, "":
+			column = "d.metadata"
+		case MetadataScopeChunk:
+			column = "c.metadata"
+		default:
+			return "", nil, fmt.Errorf("%w: 元数据条件 %d: 未知作用范围 %q", ErrInvalidFilter, i, cond.Scope)
+		}
+		if len(cond.Path) == 0 {
+			return "", nil, fmt.Errorf("%w: 元数据条件 %d: 键路径不能为空", ErrInvalidFilter, i)
+		}
+		target := column + " #> " + param(cond.Path) + "::text[]"
+
+		switch cond.Op {
+		case MetadataOpExists:
+			conds = append(conds, target+" IS NOT NULL")
+		case MetadataOpIn:
+			if len(cond.Values) == 0 {
+				return "", nil, fmt.Errorf("%w: 元数据条件 %d: in 至少需要一个值", ErrInvalidFilter, i)
+			}
+			values := make([]string, len(cond.Values))
+			for j, v := range cond.Values {
+				if !json.Valid(v) {
+					return "", nil, fmt.Errorf("%w: 元数据条件 %d: 值 %d 不是合法 JSON", ErrInvalidFilter, i, j)
+				}
+				values[j] = string(v)
+			}
+			conds = append(conds, target+" = ANY("+param(values)+"::jsonb[])")
+		case MetadataOpContains:
+			value, err := singleMetadataValue(i, cond)
+			if err != nil {
+				return "", nil, err
+			}
+			conds = append(conds, target+" @> "+param(value)+"::jsonb")
+		default:
+			operator, ok := metadataComparisons[cond.Op]
+			if !ok {
+				return "", nil, fmt.Errorf("%w: 元数据条件 %d: 未知运算符 %q", ErrInvalidFilter, i, cond.Op)
+			}
+			value, err := singleMetadataValue(i, cond)
+			if err != nil {
+				return "", nil, err
+			}
+			conds = append(conds, target+" "+operator+" "+param(value)+"::jsonb")
+		}
+	}
+
+	if len(conds) == 0 {
+		return "", nil, nil
+	}
+	return "\n\t\tAND " + strings.Join(conds, "\n\t\tAND "), args, nil
+}
+
+func singleMetadataValue(i int, cond MetadataCondition) (string, error) {
+	if len(cond.Values) != 1 {
+		return "", fmt.Errorf("%w: 元数据条件 %d: %s 需要恰好一个值", ErrInvalidFilter, i, cond.Op)
+	}
+	if !json.Valid(cond.Values[0]) {
+		return "", fmt.Errorf("%w: 元数据条件 %d: 值不是合法 JSON", ErrInvalidFilter, i)
+	}
+	return string(cond.Values[0]), nil
+}
@@ -0,0 +1,175 @@
+package adapters
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSearchFilterWhereClause(t *testing.T) {
+	after := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	raw := func(s string) json.RawMessage { return json.RawMessage(s) }
+
+	tests := []struct {
+		name     string
+		filter   SearchFilter
+		firstArg int
+		conds    []string
+		args     []interface{}
+	}{
+		{
+			name:     "zero filter",
+			firstArg: 3,
+		},
+		{
+			name: "fixed fields in order",
+			filter: SearchFilter{
+				CollectionID:  "c1",
+				DocumentIDs:   []string{"d1", "d2"},
+				Tags:          []string{"go", "rag"},
+				Uploader:      "alice",
+				CreatedAfter:  after,
+				CreatedBefore: before,
+			},
+			firstArg: 3,
+			conds: []string{
+				"d.collection_id = $3::uuid",
+				"c.document_id = ANY($4::uuid[])",
+				"d.metadata -> 'tags' ?& $5::text[]",
+				"d.metadata ->> 'uploader' = $6",
+				"d.created_at >= $7",
+				"d.created_at <= $8",
+			},
+			args: []interface{}{"c1", []string{"d1", "d2"}, []string{"go", "rag"}, "alice", after, before},
+		},
+		{
+			name:     "embedding model reuses its placeholder",
+			filter:   SearchFilter{Uploader: "bob", EmbeddingModel: "bge-m3"},
+			firstArg: 1,
+			conds: []string{
+				"d.metadata ->> 'uploader' = $1",
+				"COALESCE(d.metadata ->> 'embedding_model', $2) = $2",
+			},
+			args: []interface{}{"bob", "bge-m3"},
+		},
+		{
+			name: "metadata conditions",
+			filter: SearchFilter{Metadata: []MetadataCondition{
+				{Path: []string{"lang"}, Op: MetadataOpEq, Values: []json.RawMessage{raw(`"zh"`)}},
+				{Scope: MetadataScopeChunk, Path: []string{"page_start"}, Op: MetadataOpGte, Values: []json.RawMessage{raw(`3`)}},
+				{Scope: MetadataScopeDocument, Path: []string{"source", "kind"}, Op: MetadataOpIn, Values: []json.RawMessage{raw(`"pdf"`), raw(`"md"`)}},
+				{Path: []string{"labels"}, Op: MetadataOpContains, Values: []json.RawMessage{raw(`["x"]`)}},
+				{Path: []string{"reviewed"}, Op: MetadataOpExists},
+			}},
+			firstArg: 2,
+			conds: []string{
+				"d.metadata #> $2::text[] = $3::jsonb",
+				"c.metadata #> $4::text[] >= $5::jsonb",
+				"d.metadata #> $6::text[] = ANY($7::jsonb[])",
+				"d.metadata #> $8::text[] @> $9::jsonb",
+				"d.metadata #> $10::text[] IS NOT NULL",
+			},
+			args: []interface{}{
+				[]string{"lang"}, `"zh"`,
+				[]string{"page_start"}, `3`,
+				[]string{"source", "kind"}, []string{`"pdf"`, `"md"`},
+				[]string{"labels"}, `["x"]`,
+				[]string{"reviewed"},
+			},
+		},
+		{
+			name: "user input never reaches the SQL text",
+			filter: SearchFilter{
+				Uploader: "x' OR '1'='1",
+				Metadata: []MetadataCondition{
+					{Path: []string{"a'; DROP TABLE d; --"}, Op: MetadataOpNe, Values: []json.RawMessage{raw(`"'"`)}},
+				},
+			},
+			firstArg: 1,
+			conds: []string{
+				"d.metadata ->> 'uploader' = $1",
+				"d.metadata #> $2::text[] <> $3::jsonb",
+			},
+			args: []interface{}{"x' OR '1'='1", []string{"a'; DROP TABLE d; --"}, `"'"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause, args, err := tt.filter.whereClause(tt.firstArg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := ""
+			if len(tt.conds) > 0 {
+				want = "\n\t\tAND " + strings.Join(tt.conds, "\n\t\tAND ")
+			}
+			if clause != want {
+				t.Errorf("clause = %q, want %q", clause, want)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("args = %#v, want %#v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestSearchFilterValidate(t *testing.T) {
+	value := []json.RawMessage{json.RawMessage(`1`)}
+
+	tests := []struct {
+		name    string
+		cond    MetadataCondition
+		wantErr bool
+	}{
+		{name: "valid", cond: MetadataCondition{Path: []string{"a"}, Op: MetadataOpLt, Values: value}},
+		{name: "unknown scope", cond: MetadataCondition{Scope: "table", Path: []string{"a"}, Op: MetadataOpEq, Values: value}, wantErr: true},
+		{name: "empty path", cond: MetadataCondition{Op: MetadataOpEq, Values: value}, wantErr: true},
+		{name: "unknown operator", cond: MetadataCondition{Path: []string{"a"}, Op: "like", Values: value}, wantErr: true},
+		{name: "in without values", cond: MetadataCondition{Path: []string{"a"}, Op: MetadataOpIn}, wantErr: true},
+		{name: "in with invalid JSON", cond: MetadataCondition{Path: []string{"a"}, Op: MetadataOpIn, Values: []json.RawMessage{json.RawMessage(`zh`)}}, wantErr: true},
+		{name: "comparison without value", cond: MetadataCondition{Path: []string{"a"}, Op: MetadataOpGt}, wantErr: true},
+		{name: "comparison with two values", cond: MetadataCondition{Path: []string{"a"}, Op: MetadataOpEq, Values: append(value, value...)}, wantErr: true},
+		{name: "contains with invalid JSON", cond: MetadataCondition{Path: []string{"a"}, Op: MetadataOpContains, Values: []json.RawMessage{json.RawMessage(`{`)}}, wantErr: true},
+		{name: "exists ignores values", cond: MetadataCondition{Path: []string{"a"}, Op: MetadataOpExists}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SearchFilter{Metadata: []MetadataCondition{tt.cond}}.Validate()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidFilter) {
+				t.Errorf("err = %v, want ErrInvalidFilter", err)
+			}
+		})
+	}
+}
+
+func TestSearchFilterIsZero(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter SearchFilter
+		want   bool
+	}{
+		{name: "zero", want: true},
+		{name: "empty slices", filter: SearchFilter{DocumentIDs: []string{}, Tags: []string{}}, want: true},
+		{name: "tags", filter: SearchFilter{Tags: []string{"go"}}},
+		{name: "uploader", filter: SearchFilter{Uploader: "alice"}},
+		{name: "created before", filter: SearchFilter{CreatedBefore: time.Now()}},
+		{name: "embedding model", filter: SearchFilter{EmbeddingModel: "bge-m3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.IsZero(); got != tt.want {
+				t.Errorf("IsZero() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,405 @@
+package adapters
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	createIngestionJobsTable = `
+	CREATE TABLE IF NOT EXISTS ingestion_jobs (
+		id UUID PRIMARY KEY,
+		file_key TEXT NOT NULL,
+		filename TEXT NOT NULL,
+		status TEXT NOT NULL,
+		stage TEXT NOT NULL,
+		progress INTEGER NOT NULL DEFAULT 0,
+		total_chunks INTEGER NOT NULL DEFAULT 0,
+		processed_chunks INTEGER NOT NULL DEFAULT 0,
+		failed_chunks INTEGER NOT NULL DEFAULT 0,
+		document_id TEXT NOT NULL DEFAULT '',
+		error TEXT NOT NULL DEFAULT '',
+		attempts INTEGER NOT NULL DEFAULT 0,
+		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		started_at TIMESTAMP WITH TIME ZONE,
+		finished_at TIMESTAMP WITH TIME ZONE
+	);`
+
+	addIngestionJobsDedupePolicy = `
+	ALTER TABLE ingestion_jobs ADD COLUMN IF NOT EXISTS dedupe_policy TEXT NOT NULL DEFAULT '';`
+
+	addIngestionJobsContentType = `
+	ALTER TABLE ingestion_jobs ADD COLUMN IF NOT EXISTS content_type TEXT NOT NULL DEFAULT '';`
+
+	addIngestionJobsCollectionID = `
+	ALTER TABLE ingestion_jobs ADD COLUMN IF NOT EXISTS collection_id TEXT NOT NULL DEFAULT '';`
+
+	addIngestionJobsTags = `
+	ALTER TABLE ingestion_jobs ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';`
+
+	addIngestionJobsUploader = `
+	ALTER TABLE ingestion_jobs ADD COLUMN IF NOT EXISTS uploader TEXT NOT NULL DEFAULT '';`
+
+	createIngestionJobsStatusIndex = `
+	CREATE INDEX IF NOT EXISTS idx_ingestion_jobs_status_created ON ingestion_jobs (status, created_at);`
+
+	ingestionJobColumns = `id, file_key, filename, status, stage, progress, total_chunks, processed_chunks,
+		failed_chunks, document_id, error, attempts, created_at, updated_at, started_at, finished_at, dedupe_policy,
+		content_type, collection_id, tags, uploader`
+)
+
+// JobStatus 表示摄取任务的生命周期状态
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// IngestionStage 表示摄取任务当前所处的处理阶段
+type IngestionStage string
+
+const (
+	IngestionStageQueued      IngestionStage = "queued"
+	IngestionStageDownloading IngestionStage = "downloading"
+	IngestionStageParsing     IngestionStage = "parsing"
+	IngestionStageChunking    IngestionStage = "chunking"
+	IngestionStageEmbedding   IngestionStage = "embedding"
+	IngestionStageCompleted   IngestionStage = "completed"
+)
+
+// DedupePolicy 表示上传内容与已有文档重复时的处理策略
+type DedupePolicy string
+
+const (
+	DedupeReject         DedupePolicy = "reject"
+	DedupeReturnExisting DedupePolicy = "return_existing"
+	DedupeReplace        DedupePolicy = "replace"
+)
+
+// IngestionJob 表示一次异步文档摄取任务
+type IngestionJob struct {
+	ID              string         `json:"id"`
+	FileKey         string         `json:"file_key"`
+	Filename        string         `json:"filename"`
+	Status          JobStatus      `json:"status"`
+	Stage           IngestionStage `json:"stage"`
+	Progress        int            `json:"progress"`
+	TotalChunks     int            `json:"total_chunks"`
+	ProcessedChunks int            `json:"processed_chunks"`
+	FailedChunks    int            `json:"failed_chunks"`
+	DocumentID      string         `json:"document_id"`
+	Error           string         `json:"error"`
+	Attempts        int            `json:"attempts"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	StartedAt       *time.Time     `json:"started_at,omitempty"`
+	FinishedAt      *time.Time     `json:"finished_at,omitempty"`
+	DedupePolicy    DedupePolicy   `json:"dedupe_policy"`
+	ContentType     string         `json:"content_type"`
+	CollectionID    string         `json:"collection_id"`
+	Tags            []string       `json:"tags"`
+	Uploader        string         `json:"uploader"`
+}
+
+// IngestionJobInput 描述待创建的摄取任务
+type IngestionJobInput struct {
+	FileKey      string
+	Filename     string
+	ContentType  string
+	CollectionID string
+	// Tags 和 Uploader 写入文档 metadata 的 tags 与 uploader，供检索过滤使用
+	Tags         []string
+	Uploader     string
+	DedupePolicy DedupePolicy
+}
+
+// Finished 报告任务是否已进入终态
+func (j *IngestionJob) Finished() bool {
+	return j.Status == JobStatusSucceeded || j.Status == JobStatusFailed
+}
+
+// ErrJobNotFound 表示任务不存在
+var ErrJobNotFound = errors.New("ingestion job not found")
+
+// ErrJobLeaseLost 表示 worker 领取的任务已被回收或由其他 worker 重新领取，
+// 当前 worker 应放弃处理
+var ErrJobLeaseLost = errors.New("ingestion job lease lost")
+
+// JobStore 定义了摄取任务队列的持久化接口。
+type JobStore interface {
+	// EnqueueIngestionJob 创建一个排队中的任务
+	EnqueueIngestionJob(ctx context.Context, input IngestionJobInput) (*IngestionJob, error)
+	// ClaimIngestionJob 领取最早的排队任务并标记为运行中，没有任务时返回 nil
+	ClaimIngestionJob(ctx context.Context) (*IngestionJob, error)
+	// UpdateIngestionJob 持久化任务的状态、阶段、进度与结果。
+	// 仅当任务仍处于 job.Attempts 对应的那次运行中时生效，否则返回 ErrJobLeaseLost
+	UpdateIngestionJob(ctx context.Context, job *IngestionJob) error
+	// TouchIngestionJob 刷新运行中任务的 updated_at 以续租，
+	// 任务不再处于 attempt 对应的运行中时返回 ErrJobLeaseLost
+	TouchIngestionJob(ctx context.Context, id string, attempt int) error
+	GetIngestionJob(ctx context.Context, id string) (*IngestionJob, error)
+	// ListIngestionJobs 按创建时间倒序列出任务，status 为空时不过滤
+	ListIngestionJobs(ctx context.Context, pageSize int, cursor string, status JobStatus) ([]IngestionJob, string, error)
+	// RequeueStaleIngestionJobs 将长时间未更新的运行中任务重新排队，
+	// 尝试次数达到 maxAttempts 的任务直接标记为失败
+	RequeueStaleIngestionJobs(ctx context.Context, staleAfter time.Duration, maxAttempts int) (int64, error)
+}
+
+var _ JobStore = (*PostgresJobStore)(nil)
+
+// PostgresJobStore 使用 PostgreSQL 表实现任务队列，
+// 通过 FOR UPDATE SKIP LOCKED 保证多个 worker 不会重复领取任务。
+type PostgresJobStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresJobStore 创建任务存储并确保摄取任务表与重建任务表存在
+func NewPostgresJobStore(dsn string) (*PostgresJobStore, error) {
+	ctx := context.Background()
+
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("解析数据库连接字符串失败: %w", err)
+	}
+	config.MaxConns = 5
+	config.MinConns = 1
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("无法创建数据库连接池: %w", err)
+	}
+	if err = pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("数据库 ping 失败: %w", err)
+	}
+
+	if _, err = pool.Exec(ctx, createIngestionJobsTable); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("无法创建 ingestion_jobs 表: %w", err)
+	}
+	for _, stmt := range []string{
+		addIngestionJobsDedupePolicy, addIngestionJobsContentType, addIngestionJobsCollectionID,
+		addIngestionJobsTags, addIngestionJobsUploader,
+	} {
+		if _, err = pool.Exec(ctx, stmt); err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("无法为 ingestion_jobs 表补充列: %w", err)
+		}
+	}
+	if _, err = pool.Exec(ctx, createIngestionJobsStatusIndex); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("无法为 ingestion_jobs 表创建索引: %w", err)
+	}
+	logger.Get().Info("表 ingestion_jobs 已准备就绪")
+
+	for _, stmt := range []string{createReindexJobsTable, createReindexJobsRunningIndex} {
+		if _, err = pool.Exec(ctx, stmt); err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("无法创建 reindex_jobs 表: %w", err)
+		}
+	}
+
+	return &PostgresJobStore{pool: pool}, nil
+}
+
+// Close 关闭连接池
+func (s *PostgresJobStore) Close() {
+	s.pool.Close()
+}
+
+// EnqueueIngestionJob 创建一个排队中的任务
+func (s *PostgresJobStore) EnqueueIngestionJob(ctx context.Context, input IngestionJobInput) (*IngestionJob, error) {
+	row := s.pool.QueryRow(ctx,
+		`INSERT INTO ingestion_jobs (id, file_key, filename, status, stage, dedupe_policy, content_type, collection_id,
+			tags, uploader)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, COALESCE($9::text[], '{}'), $10)
+		RETURNING `+ingestionJobColumns,
+		uuid.New().String(), input.FileKey, input.Filename, JobStatusQueued, IngestionStageQueued, input.DedupePolicy,
+		input.ContentType, input.CollectionID, input.Tags, input.Uploader)
+
+	job, err := scanIngestionJob(row)
+	if err != nil {
+		return nil, fmt.Errorf("创建摄取任务失败: %w", err)
+	}
+	return job, nil
+}
+
+// ClaimIngestionJob 领取最早的排队任务
+func (s *PostgresJobStore) ClaimIngestionJob(ctx context.Context) (*IngestionJob, error) {
+	row := s.pool.QueryRow(ctx,
+		`UPDATE ingestion_jobs
+		SET status = $1, attempts = attempts + 1, started_at = NOW(), updated_at = NOW()
+		WHERE id = (
+			SELECT id FROM ingestion_jobs
+			WHERE status = $2
+			ORDER BY created_at
+			FOR UPDATE SKIP LOCKED
+			LIMIT 1
+		)
+		RETURNING `+ingestionJobColumns,
+		JobStatusRunning, JobStatusQueued)
+
+	job, err := scanIngestionJob(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("领取摄取任务失败: %w", err)
+	}
+	return job, nil
+}
+
+// UpdateIngestionJob 持久化任务的可变字段。
+// 以领取时的 attempts 作为租约，任务被回收后旧 worker 的更新不会覆盖新 worker 的状态。
+func (s *PostgresJobStore) UpdateIngestionJob(ctx context.Context, job *IngestionJob) error {
+	cmdTag, err := s.pool.Exec(ctx,
+		`UPDATE ingestion_jobs
+		SET status = $2, stage = $3, progress = $4, total_chunks = $5, processed_chunks = $6,
+			failed_chunks = $7, document_id = $8, error = $9, finished_at = $10, updated_at = NOW()
+		WHERE id = $1 AND attempts = $11 AND status = $12`,
+		job.ID, job.Status, job.Stage, job.Progress, job.TotalChunks, job.ProcessedChunks,
+		job.FailedChunks, job.DocumentID, job.Error, job.FinishedAt, job.Attempts, JobStatusRunning)
+	if err != nil {
+		return fmt.Errorf("更新摄取任务失败: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return ErrJobLeaseLost
+	}
+	return nil
+}
+
+// TouchIngestionJob 为运行中的任务续租
+func (s *PostgresJobStore) TouchIngestionJob(ctx context.Context, id string, attempt int) error {
+	cmdTag, err := s.pool.Exec(ctx,
+		`UPDATE ingestion_jobs SET updated_at = NOW()
+		WHERE id = $1 AND attempts = $2 AND status = $3`,
+		id, attempt, JobStatusRunning)
+	if err != nil {
+		return fmt.Errorf("刷新摄取任务租约失败: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return ErrJobLeaseLost
+	}
+	return nil
+}
+
+// GetIngestionJob 按 ID 获取任务
+func (s *PostgresJobStore) GetIngestionJob(ctx context.Context, id string) (*IngestionJob, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+ingestionJobColumns+` FROM ingestion_jobs WHERE id = $1`, id)
+	job, err := scanIngestionJob(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询摄取任务失败: %w", err)
+	}
+	return job, nil
+}
+
+type jobCursor struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListIngestionJobs 返回任务列表（按创建时间倒序）
+func (s *PostgresJobStore) ListIngestionJobs(ctx context.Context, pageSize int, cursor string, status JobStatus) ([]IngestionJob, string, error) {
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 200 {
+		pageSize = 200
+	}
+
+	query := `SELECT ` + ingestionJobColumns + ` FROM ingestion_jobs WHERE ($1 = '' OR status = $1)`
+	args := []interface{}{string(status)}
+
+	if cursor != "" {
+		var cur jobCursor
+		decoded, err := base64.StdEncoding.DecodeString(cursor)
+		if err == nil && json.Unmarshal(decoded, &cur) == nil && !cur.CreatedAt.IsZero() && cur.ID != "" {
+			query += ` AND ((created_at < $2) OR (created_at = $2 AND id < $3))`
+			args = append(args, cur.CreatedAt, cur.ID)
+		}
+	}
+	query += fmt.Sprintf(` ORDER BY created_at DESC, id DESC LIMIT $%d`, len(args)+1)
+	args = append(args, pageSize)
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("查询摄取任务列表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []IngestionJob
+	for rows.Next() {
+		job, err := scanIngestionJob(rows)
+		if err != nil {
+			logger.Get().Error("扫描摄取任务行失败", "error", err)
+			continue
+		}
+		jobs = append(jobs, *job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("遍历摄取任务行失败: %w", err)
+	}
+
+	var nextCursor string
+	if len(jobs) == pageSize {
+		last := jobs[len(jobs)-1]
+		payload, err := json.Marshal(jobCursor{ID: last.ID, CreatedAt: last.CreatedAt.UTC()})
+		if err == nil {
+			nextCursor = base64.StdEncoding.EncodeToString(payload)
+		}
+	}
+
+	return jobs, nextCursor, nil
+}
+
+// RequeueStaleIngestionJobs 回收因进程退出而停滞的任务
+func (s *PostgresJobStore) RequeueStaleIngestionJobs(ctx context.Context, staleAfter time.Duration, maxAttempts int) (int64, error) {
+	cmdTag, err := s.pool.Exec(ctx,
+		`UPDATE ingestion_jobs
+		SET status = CASE WHEN attempts >= $3 THEN $4 ELSE $5 END,
+			stage = CASE WHEN attempts >= $3 THEN stage ELSE $6 END,
+			error = CASE WHEN attempts >= $3 THEN 'worker stopped responding too many times' ELSE error END,
+			finished_at = CASE WHEN attempts >= $3 THEN NOW() ELSE NULL END,
+			updated_at = NOW()
+		WHERE status = $1 AND updated_at < NOW() - $2::interval`,
+		JobStatusRunning, fmt.Sprintf("%d seconds", int(staleAfter.Seconds())), maxAttempts,
+		JobStatusFailed, JobStatusQueued, IngestionStageQueued)
+	if err != nil {
+		return 0, fmt.Errorf("回收停滞摄取任务失败: %w", err)
+	}
+	if n := cmdTag.RowsAffected(); n > 0 {
+		logger.Get().Warn("回收停滞的摄取任务", slog.Int64("count", n))
+	}
+	return cmdTag.RowsAffected(), nil
+}
+
+func scanIngestionJob(row pgx.Row) (*IngestionJob, error) {
+	var job IngestionJob
+	err := row.Scan(
+		&job.ID, &job.FileKey, &job.Filename, &job.Status, &job.Stage, &job.Progress,
+		&job.TotalChunks, &job.ProcessedChunks, &job.FailedChunks, &job.DocumentID,
+		&job.Error, &job.Attempts, &job.CreatedAt, &job.UpdatedAt, &job.StartedAt, &job.FinishedAt,
+		&job.DedupePolicy, &job.ContentType, &job.CollectionID, &job.Tags, &job.Uploader,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
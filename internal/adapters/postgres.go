@@ -6,10 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pgvector/pgvector-go"
 	"log/slog"
@@ -39,30 +42,70 @@ const (
 	);`
 
 	createDocumentsTitleIndexTemplate = `
-	CREATE INDEX IF NOT EXISTS idx_gin_documents_title_%dd ON %s USING GIN (to_tsvector('chinese_zh', title));`
+	CREATE INDEX IF NOT EXISTS idx_gin_documents_title_%s ON %s USING GIN (to_tsvector('chinese_zh', title));`
 
 	createChunksContentIndexTemplate = `
-	CREATE INDEX IF NOT EXISTS idx_gin_chunks_content_%dd ON %s USING GIN (to_tsvector('chinese_zh', content));`
+	CREATE INDEX IF NOT EXISTS idx_gin_chunks_content_%s ON %s USING GIN (to_tsvector('chinese_zh', content));`
 
-	insertDocumentTemplate = `INSERT INTO %s (id, title, minio_key, metadata) VALUES ($1, $2, $3, $4)`
+	// 文档 metadata 的 GIN 索引，支持检索过滤中的 ?& 与 @> 条件
+	createDocumentsMetadataIndexTemplate = `
+	CREATE INDEX IF NOT EXISTS idx_gin_documents_metadata_%s ON %s USING GIN (metadata);`
+
+	// content_hash 列在已有表上通过 ALTER 补齐，并从 metadata.md5_hash 回填
+	addDocumentsContentHashTemplate = `
+	ALTER TABLE %s ADD COLUMN IF NOT EXISTS content_hash TEXT;`
+
+	backfillDocumentsContentHashTemplate = `
+	UPDATE %s SET content_hash = metadata->>'md5_hash' WHERE content_hash IS NULL AND metadata ? 'md5_hash';`
+
+	createDocumentsContentHashIndexTemplate = `
+	CREATE INDEX IF NOT EXISTS idx_documents_content_hash_%s ON %s (content_hash);`
+
+	insertDocumentTemplate = `INSERT INTO %s (id, title, minio_key, metadata, content_hash, collection_id) VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6)`
 	insertChunkTemplate    = `INSERT INTO %s (document_id, chunk_index, content, embedding, metadata) VALUES ($1, $2, $3, $4, $5)`
-	searchChunksTemplate   = `
+	// 内层按索引表达式排序以命中向量索引，外层按完整向量的相似度重新排序
+	searchChunksTemplate = `
+		SELECT * FROM (
+			SELECT
+				c.id as chunk_id,
+				c.document_id,
+				c.chunk_index,
+				d.title,
+				c.content,
+				1 - (c.embedding <=> $1) as similarity,
+				c.metadata
+			FROM %s c
+			JOIN %s d ON d.id = c.document_id
+			WHERE 1 - (c.embedding <=> $1) > $2%s
+			ORDER BY %s
+			LIMIT %d
+		) candidates
+		ORDER BY similarity DESC
+		LIMIT $3`
+	// 全文检索命中 content 上的中文分词 GIN 索引；ts_rank_cd 归一化选项 32 把得分映射到 [0,1)
+	searchChunksByKeywordsTemplate = `
 		SELECT
 			c.id as chunk_id,
 			c.document_id,
+			c.chunk_index,
+			d.title,
 			c.content,
-			1 - (c.embedding <=> $1) as similarity,
+			ts_rank_cd(to_tsvector('chinese_zh', c.content), q.query, 32) as rank,
 			c.metadata
 		FROM %s c
-		WHERE 1 - (c.embedding <=> $1) > $2
-		ORDER BY c.embedding <=> $1
-		LIMIT $3`
+		JOIN %s d ON d.id = c.document_id
+		CROSS JOIN websearch_to_tsquery('chinese_zh', $1) AS q(query)
+		WHERE to_tsvector('chinese_zh', c.content) @@ q.query%s
+		ORDER BY rank DESC
+		LIMIT $2`
 )
 
 // ChunkSearchResult 表示分块搜索结果
 type ChunkSearchResult struct {
 	ChunkID    string                 `json:"chunk_id"`
 	DocumentID string                 `json:"document_id"`
+	ChunkIndex int                    `json:"chunk_index"`
+	Title      string                 `json:"title"`
 	Content    string                 `json:"content"`
 	Similarity float32                `json:"similarity"`
 	Metadata   map[string]interface{} `json:"metadata,omitempty"`
@@ -70,11 +113,36 @@ type ChunkSearchResult struct {
 
 // DocumentRecord 表示数据库中的文档行
 type DocumentRecord struct {
-	ID        string                 `json:"id"`
-	Title     string                 `json:"title"`
-	MinioKey  string                 `json:"minio_key"`
-	Metadata  map[string]interface{} `json:"metadata,omitempty"`
-	CreatedAt time.Time              `json:"created_at"`
+	ID           string                 `json:"id"`
+	Title        string                 `json:"title"`
+	MinioKey     string                 `json:"minio_key"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+	CollectionID string                 `json:"collection_id,omitempty"`
+}
+
+// DocumentInput 表示待写入的文档
+type DocumentInput struct {
+	Title       string
+	MinioKey    string
+	ContentHash string
+	Metadata    map[string]interface{}
+	// CollectionID 为空时文档不属于任何集合
+	CollectionID string
+	// ReplaceExisting 在同一事务中删除同一集合内内容哈希相同的已有文档；
+	// 为 false 时若已存在相同文档则返回 *DuplicateDocumentError
+	ReplaceExisting bool
+	// TableSet 为生成向量时的生效表集，非空且已被切换时返回 ErrTableSetChanged，
+	// 避免旧模型的向量写入新表集
+	TableSet string
+}
+
+// ChunkRecord 表示待写入的文档块
+type ChunkRecord struct {
+	Index     int
+	Content   string
+	Embedding []float32
+	Metadata  map[string]interface{}
 }
 
 type documentCursor struct {
@@ -85,30 +153,66 @@ type documentCursor struct {
 // ErrDocumentNotFound 表示文档不存在
 var ErrDocumentNotFound = errors.New("document not found")
 
+// ErrDuplicateDocument 表示同一集合内已存在内容哈希相同的文档
+var ErrDuplicateDocument = errors.New("duplicate document")
+
+// DuplicateDocumentError 由 StoreDocumentWithChunks 返回，携带已存在文档的 ID
+type DuplicateDocumentError struct {
+	ExistingID string
+}
+
+func (e *DuplicateDocumentError) Error() string {
+	return fmt.Sprintf("duplicate of existing document %s", e.ExistingID)
+}
+
+func (e *DuplicateDocumentError) Unwrap() error { return ErrDuplicateDocument }
+
 // VectorDB 定义了向量数据库操作的接口。
 type VectorDB interface {
 	StoreDocument(ctx context.Context, title, minioKey string, metadata map[string]interface{}) (string, error)
 	StoreChunk(ctx context.Context, docID string, chunkIndex int, content string, embedding []float32, metadata map[string]interface{}) error
-	SearchSimilarChunks(ctx context.Context, queryVector []float32, limit int, threshold float32) ([]ChunkSearchResult, error)
-	ListDocuments(ctx context.Context, pageSize int, cursor string) ([]DocumentRecord, string, error)
-	DeleteDocument(ctx context.Context, documentID string) error
+	StoreDocumentWithChunks(ctx context.Context, doc DocumentInput, chunks []ChunkRecord) (string, error)
+	FindDocumentByContentHash(ctx context.Context, collectionID, contentHash string) (*DocumentRecord, error)
+	SearchSimilarChunks(ctx context.Context, queryVector []float32, limit int, threshold float32, filter SearchFilter, params ANNParams) ([]ChunkSearchResult, error)
+	SearchByKeywords(ctx context.Context, keywords []string, limit int, filter SearchFilter) ([]ChunkSearchResult, error)
+	ListDocuments(ctx context.Context, collectionID string, pageSize int, cursor string) ([]DocumentRecord, string, error)
+	DeleteDocument(ctx context.Context, collectionID, documentID string) error
+	CreateCollection(ctx context.Context, name, description string, settings CollectionSettings) (*Collection, error)
+	GetCollection(ctx context.Context, id string) (*Collection, error)
+	ListCollections(ctx context.Context) ([]Collection, error)
+	DeleteCollection(ctx context.Context, id string) error
 	GetDimensions() int
 	GetTableNames() (documents, chunks string)
+	// ActiveTableSet 返回当前生效的表集，查询向量须使用其模型生成
+	ActiveTableSet() TableSet
 }
 
 var _ VectorDB = (*PostgresVectorDB)(nil)
 
 // PostgresVectorDB 实现了 VectorDB 接口，使用 PostgreSQL 和 pgvector。
+// 读写都作用于当前生效的表集，重建索引切换表集时原子替换。
 type PostgresVectorDB struct {
-	pool           *pgxpool.Pool
-	dimensions     int
-	documentsTable string
-	chunksTable    string
+	pool   *pgxpool.Pool
+	index  VectorIndexOptions
+	active atomic.Pointer[tableSetState]
+}
+
+// tableSetState 是生效表集及其向量索引表达式
+type tableSetState struct {
+	TableSet
+	plan vectorIndexPlan
+}
+
+// tables 返回当前生效的表集。同一操作内应只取一次，避免中途切换导致跨表集读写。
+func (db *PostgresVectorDB) tables() *tableSetState {
+	return db.active.Load()
 }
 
 // NewPostgresVectorDB 创建并返回一个新的 PostgresVectorDB 实例。
-// dimensions: 向量维度，用于生成表名和向量字段
-func NewPostgresVectorDB(dsn string, dimensions int) (*PostgresVectorDB, error) {
+// model、dimensions: 配置的向量模型及维度，首次启动时用于登记表集；
+// 已有生效表集时以表集为准
+// index: 向量索引的构建与默认查询参数
+func NewPostgresVectorDB(dsn, model string, dimensions int, index VectorIndexOptions) (*PostgresVectorDB, error) {
 	ctx := context.Background()
 
 	// 1. 配置连接池
@@ -171,52 +275,104 @@ func NewPostgresVectorDB(dsn string, dimensions int) (*PostgresVectorDB, error)
 	}
 	logger.Get().Info("中文分词配置 'chinese_zh' 已准备就绪")
 
-	// 7. 根据维度生成表名
-	documentsTable := fmt.Sprintf("document_%dd", dimensions)
-	chunksTable := fmt.Sprintf("document_chunk_%dd", dimensions)
+	// 7. 表集登记表，记录每套表由哪个向量模型生成
+	if _, err = pool.Exec(ctx, createTableSetsTable); err != nil {
+		return nil, fmt.Errorf("无法创建 vector_table_sets 表: %w", err)
+	}
+	if _, err = pool.Exec(ctx, createTableSetsActiveIndex); err != nil {
+		return nil, fmt.Errorf("无法为 vector_table_sets 表创建索引: %w", err)
+	}
 
-	// 8. 创建文档表和文档块表
-	createDocumentsTable := fmt.Sprintf(createDocumentsTableTemplate, documentsTable)
-	createChunksTable := fmt.Sprintf(createChunksTableTemplate, chunksTable, documentsTable, dimensions)
+	db := &PostgresVectorDB{pool: pool, index: index}
 
-	// 执行创建表的操作
-	_, err = pool.Exec(ctx, createDocumentsTable)
+	// 8. 加载当前生效的表集，首次启动时按配置的模型和维度登记
+	active, err := db.loadActiveTableSet(ctx)
+	if errors.Is(err, ErrTableSetNotFound) {
+		active = &TableSet{Name: fmt.Sprintf("%dd", dimensions), Model: model, Dimensions: dimensions}
+		err = db.registerActiveTableSet(ctx, active)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("无法创建 rag_documents 表: %w", err)
+		return nil, err
+	}
+	if active.Model != model || active.Dimensions != dimensions {
+		logger.Get().Warn("配置的向量模型与当前表集不一致，继续使用表集的模型，请通过重建索引迁移",
+			slog.String("table_set", active.Name),
+			slog.String("table_set_model", active.Model),
+			slog.Int("table_set_dimensions", active.Dimensions),
+			slog.String("configured_model", model),
+			slog.Int("configured_dimensions", dimensions),
+		)
 	}
 
-	_, err = pool.Exec(ctx, createChunksTable)
-	if err != nil {
-		return nil, fmt.Errorf("无法创建 document_chunks 表: %w", err)
+	// 9. 创建表集的表、全文索引与向量索引
+	if err = createTableSet(ctx, pool, *active); err != nil {
+		return nil, err
+	}
+	plan := newVectorIndexPlan(active.Dimensions, index)
+	if err = ensureVectorIndex(ctx, pool, *active, index, plan); err != nil {
+		return nil, err
+	}
+	db.active.Store(&tableSetState{TableSet: *active, plan: plan})
+
+	return db, nil
+}
+
+// createTableSet 创建表集的文档、分块、集合表及其全文索引，已存在时补齐新增的列和索引。
+// 向量索引由 ensureVectorIndex 单独创建，以便在数据写入后再构建。
+func createTableSet(ctx context.Context, pool *pgxpool.Pool, set TableSet) error {
+	collectionsTable, documentsTable, chunksTable := set.CollectionsTable(), set.DocumentsTable(), set.ChunksTable()
+
+	// 文档表和文档块表
+	if _, err := pool.Exec(ctx, fmt.Sprintf(createDocumentsTableTemplate, documentsTable)); err != nil {
+		return fmt.Errorf("无法创建 %s 表: %w", documentsTable, err)
+	}
+	if _, err := pool.Exec(ctx, fmt.Sprintf(createChunksTableTemplate, chunksTable, documentsTable, set.Dimensions)); err != nil {
+		return fmt.Errorf("无法创建 %s 表: %w", chunksTable, err)
 	}
 	logger.Get().Info(fmt.Sprintf("表 %s 和 %s 已准备就绪", documentsTable, chunksTable))
 
-	// 9. 为 title 和 content 字段创建中文分词 GIN 索引
-	createDocumentsTitleIndex := fmt.Sprintf(createDocumentsTitleIndexTemplate, dimensions, documentsTable)
-	createChunksContentIndex := fmt.Sprintf(createChunksContentIndexTemplate, dimensions, chunksTable)
+	// title、content 的中文分词 GIN 索引及 metadata 的 GIN 索引
+	for _, stmt := range []string{
+		fmt.Sprintf(createDocumentsTitleIndexTemplate, set.Name, documentsTable),
+		fmt.Sprintf(createChunksContentIndexTemplate, set.Name, chunksTable),
+		fmt.Sprintf(createDocumentsMetadataIndexTemplate, set.Name, documentsTable),
+	} {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("无法为表集 %s 创建 GIN 索引: %w", set.Name, err)
+		}
+	}
 
-	_, err = pool.Exec(ctx, createDocumentsTitleIndex)
-	if err != nil {
-		return nil, fmt.Errorf("无法为 documents 表的 title 创建 GIN 索引: %w", err)
+	// 内容哈希列及索引，用于上传去重
+	for _, stmt := range []string{
+		fmt.Sprintf(addDocumentsContentHashTemplate, documentsTable),
+		fmt.Sprintf(backfillDocumentsContentHashTemplate, documentsTable),
+		fmt.Sprintf(createDocumentsContentHashIndexTemplate, set.Name, documentsTable),
+	} {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("无法为 %s 表准备 content_hash 列: %w", documentsTable, err)
+		}
 	}
 
-	_, err = pool.Exec(ctx, createChunksContentIndex)
-	if err != nil {
-		return nil, fmt.Errorf("无法为 chunks 表的 content 创建 GIN 索引: %w", err)
+	// 集合表及文档的 collection_id 列
+	for _, stmt := range []string{
+		fmt.Sprintf(createCollectionsTableTemplate, collectionsTable),
+		fmt.Sprintf(addDocumentsCollectionTemplate, documentsTable, collectionsTable),
+		fmt.Sprintf(createDocumentsCollectionIndexTemplate, set.Name, documentsTable),
+	} {
+		if _, err := pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("无法准备集合表及 collection_id 列: %w", err)
+		}
 	}
-	logger.Get().Info(fmt.Sprintf("为表 %s 和 %s 的文本内容创建了中文分词 GIN 索引", documentsTable, chunksTable))
+	logger.Get().Info(fmt.Sprintf("集合表 %s 已准备就绪", collectionsTable))
 
-	return &PostgresVectorDB{
-		pool:           pool,
-		dimensions:     dimensions,
-		documentsTable: documentsTable,
-		chunksTable:    chunksTable,
-	}, nil
+	return nil
 }
 
 // StoreDocument 存储文档并返回文档ID
 func (db *PostgresVectorDB) StoreDocument(ctx context.Context, title, minioKey string, metadata map[string]interface{}) (string, error) {
+	t := db.tables()
 	docID := uuid.New().String()
+	contentHash, _ := metadata["md5_hash"].(string)
 
 	metadataJSON, err := json.Marshal(metadata)
 	if err != nil {
@@ -224,8 +380,8 @@ func (db *PostgresVectorDB) StoreDocument(ctx context.Context, title, minioKey s
 	}
 
 	_, err = db.pool.Exec(ctx,
-		fmt.Sprintf(insertDocumentTemplate, db.documentsTable),
-		docID, title, minioKey, metadataJSON)
+		fmt.Sprintf(insertDocumentTemplate, t.DocumentsTable()),
+		docID, title, minioKey, metadataJSON, contentHash, nil)
 	if err != nil {
 		return "", fmt.Errorf("存储文档失败: %w", err)
 	}
@@ -235,13 +391,14 @@ func (db *PostgresVectorDB) StoreDocument(ctx context.Context, title, minioKey s
 
 // StoreChunk 存储文档块和对应的向量
 func (db *PostgresVectorDB) StoreChunk(ctx context.Context, docID string, chunkIndex int, content string, embedding []float32, metadata map[string]interface{}) error {
+	t := db.tables()
 	metadataJSON, err := json.Marshal(metadata)
 	if err != nil {
 		return fmt.Errorf("序列化 metadata 失败: %w", err)
 	}
 
 	_, err = db.pool.Exec(ctx,
-		fmt.Sprintf(insertChunkTemplate, db.chunksTable),
+		fmt.Sprintf(insertChunkTemplate, t.ChunksTable()),
 		docID, chunkIndex, content, pgvector.NewVector(embedding), metadataJSON)
 	if err != nil {
 		return fmt.Errorf("存储文档块失败: %w", err)
@@ -250,15 +407,170 @@ func (db *PostgresVectorDB) StoreChunk(ctx context.Context, docID string, chunkI
 	return nil
 }
 
-// SearchSimilarChunks 基于向量相似性搜索相关文档块
-func (db *PostgresVectorDB) SearchSimilarChunks(ctx context.Context, queryVector []float32, limit int, threshold float32) ([]ChunkSearchResult, error) {
+// StoreDocumentWithChunks 在一个事务中写入文档及其全部分块，任一失败则整体回滚。
+// 带内容哈希的写入按 (集合, 内容哈希) 加事务级咨询锁后再去重，
+// 并发摄取同一文件时只有一个 worker 能写入
+func (db *PostgresVectorDB) StoreDocumentWithChunks(ctx context.Context, doc DocumentInput, chunks []ChunkRecord) (string, error) {
+	t := db.tables()
+	if doc.TableSet != "" && doc.TableSet != t.Name {
+		return "", fmt.Errorf("%w: %s -> %s", ErrTableSetChanged, doc.TableSet, t.Name)
+	}
+	docID := uuid.New().String()
+
+	metadataJSON, err := json.Marshal(doc.Metadata)
+	if err != nil {
+		return "", fmt.Errorf("序列化 metadata 失败: %w", err)
+	}
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("开启事务失败: %w", err)
+	}
+	// Commit 之后 Rollback 为空操作
+	defer func() { _ = tx.Rollback(context.WithoutCancel(ctx)) }()
+
+	if doc.ContentHash != "" {
+		lockKey := t.DocumentsTable() + "|" + doc.CollectionID + "|" + doc.ContentHash
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, lockKey); err != nil {
+			return "", fmt.Errorf("获取文档去重锁失败: %w", err)
+		}
+
+		if doc.ReplaceExisting {
+			_, err = tx.Exec(ctx,
+				fmt.Sprintf(`DELETE FROM %s WHERE content_hash = $1 AND collection_id IS NOT DISTINCT FROM $2`, t.DocumentsTable()),
+				doc.ContentHash, nullableUUID(doc.CollectionID))
+			if err != nil {
+				return "", fmt.Errorf("删除重复文档失败: %w", err)
+			}
+		} else {
+			var existingID string
+			err = tx.QueryRow(ctx,
+				fmt.Sprintf(`SELECT id FROM %s WHERE content_hash = $1 AND collection_id IS NOT DISTINCT FROM $2
+					ORDER BY created_at LIMIT 1`, t.DocumentsTable()),
+				doc.ContentHash, nullableUUID(doc.CollectionID)).Scan(&existingID)
+			switch {
+			case err == nil:
+				return "", &DuplicateDocumentError{ExistingID: existingID}
+			case !errors.Is(err, pgx.ErrNoRows):
+				return "", fmt.Errorf("检查重复文档失败: %w", err)
+			}
+		}
+	}
+
+	_, err = tx.Exec(ctx,
+		fmt.Sprintf(insertDocumentTemplate, t.DocumentsTable()),
+		docID, doc.Title, doc.MinioKey, metadataJSON, doc.ContentHash, nullableUUID(doc.CollectionID))
+	if err != nil {
+		return "", fmt.Errorf("存储文档失败: %w", err)
+	}
+
+	// 切换表集时会锁住旧文档表，插入之后再确认表集仍然生效，
+	// 保证切换后不会有文档遗留在旧表集中
+	if err := checkTableSetActive(ctx, tx, t.Name); err != nil {
+		return "", err
+	}
+
+	insertChunk := fmt.Sprintf(insertChunkTemplate, t.ChunksTable())
+	batch := &pgx.Batch{}
+	for _, chunk := range chunks {
+		chunkMetadataJSON, err := json.Marshal(chunk.Metadata)
+		if err != nil {
+			return "", fmt.Errorf("序列化分块 %d metadata 失败: %w", chunk.Index, err)
+		}
+		batch.Queue(insertChunk, docID, chunk.Index, chunk.Content, pgvector.NewVector(chunk.Embedding), chunkMetadataJSON)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for _, chunk := range chunks {
+		if _, err := results.Exec(); err != nil {
+			_ = results.Close()
+			return "", fmt.Errorf("存储文档块 %d 失败: %w", chunk.Index, err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return "", fmt.Errorf("批量写入文档块失败: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	return docID, nil
+}
+
+// SearchSimilarChunks 基于向量相似性搜索相关文档块，filter 在同一查询中限定范围，
+// params 覆盖本次查询的索引参数
+func (db *PostgresVectorDB) SearchSimilarChunks(ctx context.Context, queryVector []float32, limit int, threshold float32, filter SearchFilter, params ANNParams) ([]ChunkSearchResult, error) {
+	t := db.tables()
+	filterSQL, filterArgs, err := filter.whereClause(4)
+	if err != nil {
+		return nil, err
+	}
+
 	// 使用余弦相似度搜索相似的文档块
-	query := fmt.Sprintf(searchChunksTemplate, db.chunksTable)
+	candidates := t.plan.candidateLimit(limit)
+	query := fmt.Sprintf(searchChunksTemplate, t.ChunksTable(), t.DocumentsTable(), filterSQL, t.plan.orderBy(), candidates)
+	args := append([]interface{}{pgvector.NewVector(queryVector), threshold, limit}, filterArgs...)
 
-	rows, err := db.pool.Query(ctx, query, pgvector.NewVector(queryVector), threshold, limit)
+	results, err := db.queryWithANN(ctx, params, candidates, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("查询相似文档块失败: %w", err)
 	}
+
+	logger.Get().Info(fmt.Sprintf("向量搜索完成，找到 %d 个相似块", len(results)))
+	return results, nil
+}
+
+// SearchByKeywords 基于 chinese_zh 全文检索搜索文档块。
+// 关键词之间是“或”关系，单个关键词经分词后各词项需同时命中；
+// 结果的 Similarity 为归一化到 [0,1) 的 ts_rank_cd 得分。
+func (db *PostgresVectorDB) SearchByKeywords(ctx context.Context, keywords []string, limit int, filter SearchFilter) ([]ChunkSearchResult, error) {
+	t := db.tables()
+	tsQuery := keywordsToWebSearch(keywords)
+	if tsQuery == "" {
+		return nil, nil
+	}
+	filterSQL, filterArgs, err := filter.whereClause(3)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(searchChunksByKeywordsTemplate, t.ChunksTable(), t.DocumentsTable(), filterSQL)
+	args := append([]interface{}{tsQuery, limit}, filterArgs...)
+	rows, err := db.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("全文检索文档块失败: %w", err)
+	}
+
+	results, err := scanChunkSearchResults(rows)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].Metadata["text_rank"] = float64(results[i].Similarity)
+	}
+
+	logger.Get().Info(fmt.Sprintf("全文检索完成，找到 %d 个匹配块", len(results)))
+	return results, nil
+}
+
+// keywordsToWebSearch 把关键词拼成 websearch_to_tsquery 的 OR 查询，
+// 去掉会被解释为语法的引号、减号和独立的 or。
+func keywordsToWebSearch(keywords []string) string {
+	terms := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		keyword = strings.TrimLeft(strings.ReplaceAll(keyword, `"`, " "), "- ")
+		keyword = strings.TrimSpace(keyword)
+		if keyword == "" || strings.EqualFold(keyword, "or") {
+			continue
+		}
+		terms = append(terms, keyword)
+	}
+	return strings.Join(terms, " or ")
+}
+
+// scanChunkSearchResults 读取搜索结果行并解析 metadata，无法扫描的行会被跳过。
+func scanChunkSearchResults(rows pgx.Rows) ([]ChunkSearchResult, error) {
 	defer rows.Close()
 
 	var results []ChunkSearchResult
@@ -269,6 +581,8 @@ func (db *PostgresVectorDB) SearchSimilarChunks(ctx context.Context, queryVector
 		err := rows.Scan(
 			&result.ChunkID,
 			&result.DocumentID,
+			&result.ChunkIndex,
+			&result.Title,
 			&result.Content,
 			&result.Similarity,
 			&metadataJSON,
@@ -285,23 +599,23 @@ func (db *PostgresVectorDB) SearchSimilarChunks(ctx context.Context, queryVector
 				logger.Get().Error("解析metadata失败", "error", err)
 				result.Metadata = make(map[string]interface{})
 			}
-		} else {
+		}
+		if result.Metadata == nil {
 			result.Metadata = make(map[string]interface{})
 		}
 
 		results = append(results, result)
 	}
 
-	if err = rows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("遍历搜索结果失败: %w", err)
 	}
-
-	logger.Get().Info(fmt.Sprintf("向量搜索完成，找到 %d 个相似块", len(results)))
 	return results, nil
 }
 
-// ListDocuments 返回文档列表（按创建时间倒序）
-func (db *PostgresVectorDB) ListDocuments(ctx context.Context, pageSize int, cursor string) ([]DocumentRecord, string, error) {
+// ListDocuments 返回文档列表（按创建时间倒序），collectionID 非空时只列出该集合的文档
+func (db *PostgresVectorDB) ListDocuments(ctx context.Context, collectionID string, pageSize int, cursor string) ([]DocumentRecord, string, error) {
+	t := db.tables()
 	if pageSize <= 0 {
 		pageSize = 50
 	}
@@ -309,36 +623,21 @@ func (db *PostgresVectorDB) ListDocuments(ctx context.Context, pageSize int, cur
 		pageSize = 200
 	}
 
-	var (
-		query     string
-		args      []interface{}
-		hasCursor bool
-		cur       documentCursor
-	)
+	query := fmt.Sprintf(`SELECT id, title, minio_key, metadata, created_at, COALESCE(collection_id::text, '')
+		FROM %s
+		WHERE ($1::uuid IS NULL OR collection_id = $1::uuid)`, t.DocumentsTable())
+	args := []interface{}{nullableUUID(collectionID)}
 
 	if cursor != "" {
+		var cur documentCursor
 		decoded, err := base64.StdEncoding.DecodeString(cursor)
-		if err == nil {
-			if err := json.Unmarshal(decoded, &cur); err == nil && !cur.CreatedAt.IsZero() && cur.ID != "" {
-				hasCursor = true
-			}
+		if err == nil && json.Unmarshal(decoded, &cur) == nil && !cur.CreatedAt.IsZero() && cur.ID != "" {
+			query += ` AND ((created_at < $2) OR (created_at = $2 AND id < $3))`
+			args = append(args, cur.CreatedAt, cur.ID)
 		}
 	}
-
-	if hasCursor {
-		query = fmt.Sprintf(`SELECT id, title, minio_key, metadata, created_at
-			FROM %s
-			WHERE (created_at < $1) OR (created_at = $1 AND id < $2)
-			ORDER BY created_at DESC, id DESC
-			LIMIT $3`, db.documentsTable)
-		args = []interface{}{cur.CreatedAt, cur.ID, pageSize}
-	} else {
-		query = fmt.Sprintf(`SELECT id, title, minio_key, metadata, created_at
-			FROM %s
-			ORDER BY created_at DESC, id DESC
-			LIMIT $1`, db.documentsTable)
-		args = []interface{}{pageSize}
-	}
+	query += fmt.Sprintf(` ORDER BY created_at DESC, id DESC LIMIT $%d`, len(args)+1)
+	args = append(args, pageSize)
 
 	rows, err := db.pool.Query(ctx, query, args...)
 	if err != nil {
@@ -352,7 +651,7 @@ func (db *PostgresVectorDB) ListDocuments(ctx context.Context, pageSize int, cur
 			doc          DocumentRecord
 			metadataJSON []byte
 		)
-		if err := rows.Scan(&doc.ID, &doc.Title, &doc.MinioKey, &metadataJSON, &doc.CreatedAt); err != nil {
+		if err := rows.Scan(&doc.ID, &doc.Title, &doc.MinioKey, &metadataJSON, &doc.CreatedAt, &doc.CollectionID); err != nil {
 			logger.Get().Error("扫描文档行失败", "error", err)
 			continue
 		}
@@ -388,12 +687,46 @@ func (db *PostgresVectorDB) ListDocuments(ctx context.Context, pageSize int, cur
 	return docs, nextCursor, nil
 }
 
-// DeleteDocument 删除文档（级联删除分块）
-func (db *PostgresVectorDB) DeleteDocument(ctx context.Context, documentID string) error {
+// FindDocumentByContentHash 在集合内按内容哈希查找最早写入的文档，
+// collectionID 为空时只查找不属于任何集合的文档
+func (db *PostgresVectorDB) FindDocumentByContentHash(ctx context.Context, collectionID, contentHash string) (*DocumentRecord, error) {
+	t := db.tables()
+	var (
+		doc          DocumentRecord
+		metadataJSON []byte
+	)
+	err := db.pool.QueryRow(ctx,
+		fmt.Sprintf(`SELECT id, title, minio_key, metadata, created_at, COALESCE(collection_id::text, '')
+			FROM %s
+			WHERE content_hash = $1 AND collection_id IS NOT DISTINCT FROM $2
+			ORDER BY created_at
+			LIMIT 1`, t.DocumentsTable()),
+		contentHash, nullableUUID(collectionID)).Scan(&doc.ID, &doc.Title, &doc.MinioKey, &metadataJSON, &doc.CreatedAt, &doc.CollectionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrDocumentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("按内容哈希查询文档失败: %w", err)
+	}
+
+	doc.Metadata = make(map[string]interface{})
+	if len(metadataJSON) > 0 {
+		if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
+			logger.Get().Error("解析文档 metadata 失败", "error", err)
+		}
+	}
+	return &doc, nil
+}
+
+// DeleteDocument 删除文档（级联删除分块），collectionID 非空时文档必须属于该集合
+func (db *PostgresVectorDB) DeleteDocument(ctx context.Context, collectionID, documentID string) error {
+	t := db.tables()
 	if documentID == "" {
 		return fmt.Errorf("document id required")
 	}
-	cmdTag, err := db.pool.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, db.documentsTable), documentID)
+	cmdTag, err := db.pool.Exec(ctx,
+		fmt.Sprintf(`DELETE FROM %s WHERE id = $1 AND ($2::uuid IS NULL OR collection_id = $2::uuid)`, t.DocumentsTable()),
+		documentID, nullableUUID(collectionID))
 	if err != nil {
 		return fmt.Errorf("删除文档失败: %w", err)
 	}
@@ -405,10 +738,16 @@ func (db *PostgresVectorDB) DeleteDocument(ctx context.Context, documentID strin
 
 // GetDimensions 返回向量维度
 func (db *PostgresVectorDB) GetDimensions() int {
-	return db.dimensions
+	return db.tables().Dimensions
 }
 
 // GetTableNames 返回文档表和分块表的名称
 func (db *PostgresVectorDB) GetTableNames() (documents, chunks string) {
-	return db.documentsTable, db.chunksTable
+	t := db.tables()
+	return t.DocumentsTable(), t.ChunksTable()
+}
+
+// ActiveTableSet 返回当前生效的表集
+func (db *PostgresVectorDB) ActiveTableSet() TableSet {
+	return db.tables().TableSet
 }
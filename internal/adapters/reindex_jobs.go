@@ -0,0 +1,186 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	createReindexJobsTable = `
+	CREATE TABLE IF NOT EXISTS reindex_jobs (
+		id UUID PRIMARY KEY,
+		status TEXT NOT NULL,
+		mode TEXT NOT NULL,
+		model TEXT NOT NULL,
+		dimensions INTEGER NOT NULL,
+		source_set TEXT NOT NULL,
+		target_set TEXT NOT NULL DEFAULT '',
+		auto_cutover BOOLEAN NOT NULL DEFAULT FALSE,
+		total_documents INTEGER NOT NULL DEFAULT 0,
+		processed_documents INTEGER NOT NULL DEFAULT 0,
+		processed_chunks INTEGER NOT NULL DEFAULT 0,
+		error TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		finished_at TIMESTAMP WITH TIME ZONE
+	);`
+
+	// 同一时刻只允许一个运行中的重建任务
+	createReindexJobsRunningIndex = `
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_reindex_jobs_running ON reindex_jobs ((TRUE)) WHERE status = 'running';`
+
+	reindexJobColumns = `id, status, mode, model, dimensions, source_set, target_set, auto_cutover,
+		total_documents, processed_documents, processed_chunks, error, created_at, updated_at, finished_at`
+)
+
+// ReindexStatus 表示重建索引任务的生命周期状态
+type ReindexStatus string
+
+const (
+	// ReindexStatusRunning 正在向目标表集复制文档
+	ReindexStatusRunning ReindexStatus = "running"
+	// ReindexStatusReady 目标表集已就绪，等待切换
+	ReindexStatusReady ReindexStatus = "ready"
+	// ReindexStatusActive 已切换到目标表集
+	ReindexStatusActive ReindexStatus = "active"
+	// ReindexStatusRolledBack 切换后又回滚到源表集
+	ReindexStatusRolledBack ReindexStatus = "rolled_back"
+	ReindexStatusFailed     ReindexStatus = "failed"
+)
+
+// ReindexMode 表示重建索引的方式
+type ReindexMode string
+
+const (
+	// ReindexModeReembed 沿用现有分块，只重新生成向量
+	ReindexModeReembed ReindexMode = "reembed"
+	// ReindexModeRechunk 重新解析原文件并按当前配置分块
+	ReindexModeRechunk ReindexMode = "rechunk"
+)
+
+// ReindexJob 表示一次把全部文档迁移到新向量模型的任务
+type ReindexJob struct {
+	ID                 string        `json:"id"`
+	Status             ReindexStatus `json:"status"`
+	Mode               ReindexMode   `json:"mode"`
+	Model              string        `json:"model"`
+	Dimensions         int           `json:"dimensions"`
+	SourceSet          string        `json:"source_set"`
+	TargetSet          string        `json:"target_set"`
+	AutoCutover        bool          `json:"auto_cutover"`
+	TotalDocuments     int           `json:"total_documents"`
+	ProcessedDocuments int           `json:"processed_documents"`
+	ProcessedChunks    int           `json:"processed_chunks"`
+	Error              string        `json:"error"`
+	CreatedAt          time.Time     `json:"created_at"`
+	UpdatedAt          time.Time     `json:"updated_at"`
+	FinishedAt         *time.Time    `json:"finished_at,omitempty"`
+}
+
+var (
+	// ErrReindexJobNotFound 表示重建任务不存在
+	ErrReindexJobNotFound = errors.New("reindex job not found")
+	// ErrReindexRunning 表示已有运行中的重建任务
+	ErrReindexRunning = errors.New("a reindex job is already running")
+)
+
+// ReindexJobStore 定义了重建索引任务的持久化接口。
+type ReindexJobStore interface {
+	// CreateReindexJob 创建运行中的任务，已有运行中的任务时返回 ErrReindexRunning
+	CreateReindexJob(ctx context.Context, job *ReindexJob) error
+	// UpdateReindexJob 持久化任务的状态、进度与结果
+	UpdateReindexJob(ctx context.Context, job *ReindexJob) error
+	GetReindexJob(ctx context.Context, id string) (*ReindexJob, error)
+	// FailStaleReindexJobs 把长时间未更新的运行中任务标记为失败
+	FailStaleReindexJobs(ctx context.Context, staleAfter time.Duration) (int64, error)
+}
+
+var _ ReindexJobStore = (*PostgresJobStore)(nil)
+
+// CreateReindexJob 创建重建任务
+func (s *PostgresJobStore) CreateReindexJob(ctx context.Context, job *ReindexJob) error {
+	job.ID = uuid.New().String()
+	job.Status = ReindexStatusRunning
+	row := s.pool.QueryRow(ctx,
+		`INSERT INTO reindex_jobs (id, status, mode, model, dimensions, source_set, target_set, auto_cutover)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING `+reindexJobColumns,
+		job.ID, job.Status, job.Mode, job.Model, job.Dimensions, job.SourceSet, job.TargetSet, job.AutoCutover)
+
+	created, err := scanReindexJob(row)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return ErrReindexRunning
+	}
+	if err != nil {
+		return fmt.Errorf("创建重建任务失败: %w", err)
+	}
+	*job = *created
+	return nil
+}
+
+// UpdateReindexJob 持久化任务的可变字段
+func (s *PostgresJobStore) UpdateReindexJob(ctx context.Context, job *ReindexJob) error {
+	_, err := s.pool.Exec(ctx,
+		`UPDATE reindex_jobs
+		SET status = $2, target_set = $3, total_documents = $4, processed_documents = $5,
+			processed_chunks = $6, error = $7, finished_at = $8, updated_at = NOW()
+		WHERE id = $1`,
+		job.ID, job.Status, job.TargetSet, job.TotalDocuments, job.ProcessedDocuments,
+		job.ProcessedChunks, job.Error, job.FinishedAt)
+	if err != nil {
+		return fmt.Errorf("更新重建任务失败: %w", err)
+	}
+	return nil
+}
+
+// GetReindexJob 按 ID 获取重建任务
+func (s *PostgresJobStore) GetReindexJob(ctx context.Context, id string) (*ReindexJob, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+reindexJobColumns+` FROM reindex_jobs WHERE id = $1`, id)
+	job, err := scanReindexJob(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrReindexJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询重建任务失败: %w", err)
+	}
+	return job, nil
+}
+
+// FailStaleReindexJobs 标记因进程退出而停滞的重建任务。目标表集保留，
+// 可通过 DeleteTableSet 清理。
+func (s *PostgresJobStore) FailStaleReindexJobs(ctx context.Context, staleAfter time.Duration) (int64, error) {
+	cmdTag, err := s.pool.Exec(ctx,
+		`UPDATE reindex_jobs
+		SET status = $3, error = 'worker stopped responding', finished_at = NOW(), updated_at = NOW()
+		WHERE status = $1 AND updated_at < NOW() - $2::interval`,
+		ReindexStatusRunning, fmt.Sprintf("%d seconds", int(staleAfter.Seconds())), ReindexStatusFailed)
+	if err != nil {
+		return 0, fmt.Errorf("回收停滞重建任务失败: %w", err)
+	}
+	if n := cmdTag.RowsAffected(); n > 0 {
+		logger.Get().Warn("停滞的重建任务已标记为失败", slog.Int64("count", n))
+	}
+	return cmdTag.RowsAffected(), nil
+}
+
+func scanReindexJob(row pgx.Row) (*ReindexJob, error) {
+	var job ReindexJob
+	err := row.Scan(
+		&job.ID, &job.Status, &job.Mode, &job.Model, &job.Dimensions, &job.SourceSet, &job.TargetSet,
+		&job.AutoCutover, &job.TotalDocuments, &job.ProcessedDocuments, &job.ProcessedChunks,
+		&job.Error, &job.CreatedAt, &job.UpdatedAt, &job.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
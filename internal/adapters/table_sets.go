@@ -0,0 +1,458 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/pgvector/pgvector-go"
+)
+
+const (
+	createTableSetsTable = `
+	CREATE TABLE IF NOT EXISTS vector_table_sets (
+		name TEXT PRIMARY KEY,
+		model TEXT NOT NULL,
+		dimensions INTEGER NOT NULL,
+		active BOOLEAN NOT NULL DEFAULT FALSE,
+		created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+		activated_at TIMESTAMP WITH TIME ZONE
+	);`
+
+	// 同一时刻最多只有一个生效表集
+	createTableSetsActiveIndex = `
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_vector_table_sets_active ON vector_table_sets (active) WHERE active;`
+
+	tableSetColumns = `name, model, dimensions, active, created_at, activated_at`
+
+	// 复制集合时去掉 embedding_model 覆盖：一个表集只由一个模型生成
+	upsertCollectionsTemplate = `
+	INSERT INTO %s (id, name, description, settings, created_at)
+	SELECT id, name, description, settings - 'embedding_model', created_at FROM %s %s
+	ON CONFLICT (id) DO UPDATE SET
+		name = EXCLUDED.name, description = EXCLUDED.description, settings = EXCLUDED.settings`
+)
+
+var (
+	// ErrTableSetNotFound 表示表集不存在
+	ErrTableSetNotFound = errors.New("table set not found")
+	// ErrTableSetChanged 表示生效表集在操作过程中被切换
+	ErrTableSetChanged = errors.New("active table set changed")
+	// ErrTableSetOutOfSync 表示目标表集缺少生效表集中的文档，需要先补齐
+	ErrTableSetOutOfSync = errors.New("table set is missing documents of the active table set")
+	// ErrTableSetActive 表示不能删除生效中的表集
+	ErrTableSetActive = errors.New("table set is active")
+)
+
+// TableSet 是一套由同一向量模型生成的文档、分块与集合表。
+// 最初的表集以维度命名（如 1024d），重建索引创建的表集带随机后缀。
+type TableSet struct {
+	Name        string     `json:"name"`
+	Model       string     `json:"model"`
+	Dimensions  int        `json:"dimensions"`
+	Active      bool       `json:"active"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ActivatedAt *time.Time `json:"activated_at,omitempty"`
+}
+
+// DocumentsTable 返回文档表名
+func (s TableSet) DocumentsTable() string { return "document_" + s.Name }
+
+// ChunksTable 返回分块表名
+func (s TableSet) ChunksTable() string { return "document_chunk_" + s.Name }
+
+// CollectionsTable 返回集合表名
+func (s TableSet) CollectionsTable() string { return "collection_" + s.Name }
+
+// TableSetStore 定义了表集管理与重建索引所需的操作。
+// 文档按 ID 从源表集复制到目标表集，ID 在切换前后保持不变。
+type TableSetStore interface {
+	ActiveTableSet() TableSet
+	// RefreshActiveTableSet 重新读取生效表集，用于感知其他实例完成的切换
+	RefreshActiveTableSet(ctx context.Context) error
+	ListTableSets(ctx context.Context) ([]TableSet, error)
+	GetTableSet(ctx context.Context, name string) (*TableSet, error)
+	// CreateTableSet 为模型创建一套空表并登记为未生效
+	CreateTableSet(ctx context.Context, model string, dimensions int) (*TableSet, error)
+	// DeleteTableSet 删除未生效的表集及其数据
+	DeleteTableSet(ctx context.Context, name string) error
+	// ActivateTableSet 原子地切换生效表集。sync 为 true 时先确认目标表集包含
+	// 当前表集的全部文档，并同步集合与删除，缺少文档时返回 ErrTableSetOutOfSync
+	ActivateTableSet(ctx context.Context, name string, sync bool) error
+
+	CountDocuments(ctx context.Context, source TableSet) (int64, error)
+	// ListDocumentsMissingFrom 按创建时间返回 source 中尚未复制到 target 的文档
+	ListDocumentsMissingFrom(ctx context.Context, source, target TableSet, limit int) ([]DocumentRecord, error)
+	GetDocumentChunks(ctx context.Context, source TableSet, documentID string) ([]ChunkRecord, error)
+	// CopyDocument 把文档行连同新分块写入 target，文档已被删除时返回 false
+	CopyDocument(ctx context.Context, source, target TableSet, documentID string, chunks []ChunkRecord) (bool, error)
+}
+
+var _ TableSetStore = (*PostgresVectorDB)(nil)
+
+// loadActiveTableSet 读取登记表中的生效表集
+func (db *PostgresVectorDB) loadActiveTableSet(ctx context.Context) (*TableSet, error) {
+	set, err := scanTableSet(db.pool.QueryRow(ctx,
+		`SELECT `+tableSetColumns+` FROM vector_table_sets WHERE active`))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTableSetNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询生效表集失败: %w", err)
+	}
+	return set, nil
+}
+
+// registerActiveTableSet 把表集登记为生效，用于首次启动
+func (db *PostgresVectorDB) registerActiveTableSet(ctx context.Context, set *TableSet) error {
+	err := db.pool.QueryRow(ctx,
+		`INSERT INTO vector_table_sets (name, model, dimensions, active, activated_at)
+		VALUES ($1, $2, $3, TRUE, NOW())
+		ON CONFLICT (name) DO UPDATE SET active = TRUE, activated_at = NOW()
+		RETURNING `+tableSetColumns,
+		set.Name, set.Model, set.Dimensions).Scan(
+		&set.Name, &set.Model, &set.Dimensions, &set.Active, &set.CreatedAt, &set.ActivatedAt)
+	if err != nil {
+		return fmt.Errorf("登记表集失败: %w", err)
+	}
+	logger.Get().Info("表集已登记", slog.String("table_set", set.Name), slog.String("model", set.Model))
+	return nil
+}
+
+// checkTableSetActive 在事务内确认表集仍然生效
+func checkTableSetActive(ctx context.Context, tx pgx.Tx, name string) error {
+	var active bool
+	err := tx.QueryRow(ctx, `SELECT active FROM vector_table_sets WHERE name = $1`, name).Scan(&active)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("查询表集状态失败: %w", err)
+	}
+	if !active {
+		return fmt.Errorf("%w: %s", ErrTableSetChanged, name)
+	}
+	return nil
+}
+
+// RefreshActiveTableSet 重新读取生效表集，其他实例完成切换后由此生效
+func (db *PostgresVectorDB) RefreshActiveTableSet(ctx context.Context) error {
+	active, err := db.loadActiveTableSet(ctx)
+	if err != nil {
+		return err
+	}
+	if current := db.tables(); current.Name == active.Name {
+		return nil
+	}
+	db.active.Store(&tableSetState{TableSet: *active, plan: newVectorIndexPlan(active.Dimensions, db.index)})
+	logger.Get().Info("生效表集已切换", slog.String("table_set", active.Name), slog.String("model", active.Model))
+	return nil
+}
+
+// ListTableSets 按创建时间列出全部表集
+func (db *PostgresVectorDB) ListTableSets(ctx context.Context) ([]TableSet, error) {
+	rows, err := db.pool.Query(ctx, `SELECT `+tableSetColumns+` FROM vector_table_sets ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("查询表集列表失败: %w", err)
+	}
+	defer rows.Close()
+
+	var sets []TableSet
+	for rows.Next() {
+		set, err := scanTableSet(rows)
+		if err != nil {
+			return nil, fmt.Errorf("扫描表集行失败: %w", err)
+		}
+		sets = append(sets, *set)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("遍历表集行失败: %w", err)
+	}
+	return sets, nil
+}
+
+// GetTableSet 按名称获取表集
+func (db *PostgresVectorDB) GetTableSet(ctx context.Context, name string) (*TableSet, error) {
+	set, err := scanTableSet(db.pool.QueryRow(ctx,
+		`SELECT `+tableSetColumns+` FROM vector_table_sets WHERE name = $1`, name))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrTableSetNotFound, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询表集失败: %w", err)
+	}
+	return set, nil
+}
+
+// CreateTableSet 创建一套空表并登记为未生效
+func (db *PostgresVectorDB) CreateTableSet(ctx context.Context, model string, dimensions int) (*TableSet, error) {
+	set := &TableSet{
+		Name:       fmt.Sprintf("%dd_%s", dimensions, strings.ReplaceAll(uuid.NewString(), "-", "")[:8]),
+		Model:      model,
+		Dimensions: dimensions,
+	}
+	if err := createTableSet(ctx, db.pool, *set); err != nil {
+		return nil, err
+	}
+	err := db.pool.QueryRow(ctx,
+		`INSERT INTO vector_table_sets (name, model, dimensions) VALUES ($1, $2, $3) RETURNING created_at`,
+		set.Name, set.Model, set.Dimensions).Scan(&set.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("登记表集失败: %w", err)
+	}
+	return set, nil
+}
+
+// DeleteTableSet 删除未生效的表集
+func (db *PostgresVectorDB) DeleteTableSet(ctx context.Context, name string) error {
+	set, err := db.GetTableSet(ctx, name)
+	if err != nil {
+		return err
+	}
+	if set.Active {
+		return fmt.Errorf("%w: %s", ErrTableSetActive, name)
+	}
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() { _ = tx.Rollback(context.WithoutCancel(ctx)) }()
+
+	// 条件删除，防止与并发的切换冲突
+	cmdTag, err := tx.Exec(ctx, `DELETE FROM vector_table_sets WHERE name = $1 AND NOT active`, name)
+	if err != nil {
+		return fmt.Errorf("删除表集登记失败: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: %s", ErrTableSetActive, name)
+	}
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`DROP TABLE IF EXISTS %s, %s, %s`,
+		set.ChunksTable(), set.DocumentsTable(), set.CollectionsTable())); err != nil {
+		return fmt.Errorf("删除表集 %s 的表失败: %w", name, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	logger.Get().Info("表集已删除", slog.String("table_set", name))
+	return nil
+}
+
+// ActivateTableSet 切换生效表集。向量索引在事务外预先建好；同步时锁住当前
+// 文档表阻止新写入，确认没有遗漏文档后在同一事务内同步集合、删除源表集中
+// 已删除的文档并切换登记表。
+func (db *PostgresVectorDB) ActivateTableSet(ctx context.Context, name string, sync bool) error {
+	target, err := db.GetTableSet(ctx, name)
+	if err != nil {
+		return err
+	}
+	plan := newVectorIndexPlan(target.Dimensions, db.index)
+	if err := ensureVectorIndex(ctx, db.pool, *target, db.index, plan); err != nil {
+		return err
+	}
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() { _ = tx.Rollback(context.WithoutCancel(ctx)) }()
+
+	source, err := scanTableSet(tx.QueryRow(ctx,
+		`SELECT `+tableSetColumns+` FROM vector_table_sets WHERE active FOR UPDATE`))
+	if err != nil {
+		return fmt.Errorf("查询生效表集失败: %w", err)
+	}
+	if source.Name == target.Name {
+		return db.RefreshActiveTableSet(ctx)
+	}
+
+	if sync {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`LOCK TABLE %s IN SHARE MODE`, source.DocumentsTable())); err != nil {
+			return fmt.Errorf("锁定文档表失败: %w", err)
+		}
+		var missing int64
+		err := tx.QueryRow(ctx, fmt.Sprintf(
+			`SELECT COUNT(*) FROM %s s WHERE NOT EXISTS (SELECT 1 FROM %s t WHERE t.id = s.id)`,
+			source.DocumentsTable(), target.DocumentsTable())).Scan(&missing)
+		if err != nil {
+			return fmt.Errorf("比对表集文档失败: %w", err)
+		}
+		if missing > 0 {
+			return fmt.Errorf("%w: %d documents", ErrTableSetOutOfSync, missing)
+		}
+
+		for _, stmt := range []string{
+			fmt.Sprintf(`DELETE FROM %s t WHERE NOT EXISTS (SELECT 1 FROM %s s WHERE s.id = t.id)`,
+				target.CollectionsTable(), source.CollectionsTable()),
+			fmt.Sprintf(upsertCollectionsTemplate, target.CollectionsTable(), source.CollectionsTable(), ""),
+			fmt.Sprintf(`DELETE FROM %s t WHERE NOT EXISTS (SELECT 1 FROM %s s WHERE s.id = t.id)`,
+				target.DocumentsTable(), source.DocumentsTable()),
+		} {
+			if _, err := tx.Exec(ctx, stmt); err != nil {
+				return fmt.Errorf("同步表集失败: %w", err)
+			}
+		}
+	}
+
+	// 唯一索引要求先取消原表集再生效新表集
+	if _, err := tx.Exec(ctx, `UPDATE vector_table_sets SET active = FALSE WHERE active`); err != nil {
+		return fmt.Errorf("取消原表集失败: %w", err)
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE vector_table_sets SET active = TRUE, activated_at = NOW() WHERE name = $1`, target.Name); err != nil {
+		return fmt.Errorf("生效新表集失败: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+
+	target.Active = true
+	db.active.Store(&tableSetState{TableSet: *target, plan: plan})
+	logger.Get().Info("生效表集已切换",
+		slog.String("from", source.Name),
+		slog.String("to", target.Name),
+		slog.String("model", target.Model),
+	)
+	return nil
+}
+
+// CountDocuments 返回表集中的文档数
+func (db *PostgresVectorDB) CountDocuments(ctx context.Context, source TableSet) (int64, error) {
+	var count int64
+	if err := db.pool.QueryRow(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM %s`, source.DocumentsTable())).Scan(&count); err != nil {
+		return 0, fmt.Errorf("统计文档数失败: %w", err)
+	}
+	return count, nil
+}
+
+// ListDocumentsMissingFrom 返回尚未复制到 target 的文档，按创建时间排序
+func (db *PostgresVectorDB) ListDocumentsMissingFrom(ctx context.Context, source, target TableSet, limit int) ([]DocumentRecord, error) {
+	rows, err := db.pool.Query(ctx, fmt.Sprintf(
+		`SELECT s.id, s.title, s.minio_key, s.metadata, s.created_at, COALESCE(s.collection_id::text, '')
+		FROM %s s
+		WHERE NOT EXISTS (SELECT 1 FROM %s t WHERE t.id = s.id)
+		ORDER BY s.created_at, s.id
+		LIMIT $1`, source.DocumentsTable(), target.DocumentsTable()), limit)
+	if err != nil {
+		return nil, fmt.Errorf("查询待复制文档失败: %w", err)
+	}
+	defer rows.Close()
+
+	var docs []DocumentRecord
+	for rows.Next() {
+		var (
+			doc          DocumentRecord
+			metadataJSON []byte
+		)
+		if err := rows.Scan(&doc.ID, &doc.Title, &doc.MinioKey, &metadataJSON, &doc.CreatedAt, &doc.CollectionID); err != nil {
+			return nil, fmt.Errorf("扫描文档行失败: %w", err)
+		}
+		doc.Metadata = make(map[string]interface{})
+		if len(metadataJSON) > 0 {
+			if err := json.Unmarshal(metadataJSON, &doc.Metadata); err != nil {
+				logger.Get().Error("解析文档 metadata 失败", "error", err)
+			}
+		}
+		docs = append(docs, doc)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("遍历文档行失败: %w", err)
+	}
+	return docs, nil
+}
+
+// GetDocumentChunks 按序返回文档的分块内容和 metadata，不含向量
+func (db *PostgresVectorDB) GetDocumentChunks(ctx context.Context, source TableSet, documentID string) ([]ChunkRecord, error) {
+	rows, err := db.pool.Query(ctx, fmt.Sprintf(
+		`SELECT chunk_index, content, metadata FROM %s WHERE document_id = $1 ORDER BY chunk_index`,
+		source.ChunksTable()), documentID)
+	if err != nil {
+		return nil, fmt.Errorf("查询文档分块失败: %w", err)
+	}
+	defer rows.Close()
+
+	var chunks []ChunkRecord
+	for rows.Next() {
+		var (
+			chunk        ChunkRecord
+			metadataJSON []byte
+		)
+		if err := rows.Scan(&chunk.Index, &chunk.Content, &metadataJSON); err != nil {
+			return nil, fmt.Errorf("扫描分块行失败: %w", err)
+		}
+		chunk.Metadata = make(map[string]interface{})
+		if len(metadataJSON) > 0 {
+			if err := json.Unmarshal(metadataJSON, &chunk.Metadata); err != nil {
+				logger.Get().Error("解析分块 metadata 失败", "error", err)
+			}
+		}
+		chunks = append(chunks, chunk)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("遍历分块行失败: %w", err)
+	}
+	return chunks, nil
+}
+
+// CopyDocument 在一个事务中把文档行及新分块写入 target，文档的
+// metadata.embedding_model 更新为 target 的模型
+func (db *PostgresVectorDB) CopyDocument(ctx context.Context, source, target TableSet, documentID string, chunks []ChunkRecord) (bool, error) {
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() { _ = tx.Rollback(context.WithoutCancel(ctx)) }()
+
+	// 文档所属集合可能在上次同步之后才创建
+	_, err = tx.Exec(ctx, fmt.Sprintf(upsertCollectionsTemplate,
+		target.CollectionsTable(), source.CollectionsTable(),
+		fmt.Sprintf(`WHERE id = (SELECT collection_id FROM %s WHERE id = $1)`, source.DocumentsTable())),
+		documentID)
+	if err != nil {
+		return false, fmt.Errorf("复制文档集合失败: %w", err)
+	}
+
+	cmdTag, err := tx.Exec(ctx, fmt.Sprintf(
+		`INSERT INTO %s (id, title, minio_key, metadata, content_hash, collection_id, created_at, updated_at)
+		SELECT id, title, minio_key, COALESCE(metadata, '{}') || jsonb_build_object('embedding_model', $2::text),
+			content_hash, collection_id, created_at, updated_at
+		FROM %s WHERE id = $1
+		ON CONFLICT (id) DO NOTHING`, target.DocumentsTable(), source.DocumentsTable()),
+		documentID, target.Model)
+	if err != nil {
+		return false, fmt.Errorf("复制文档失败: %w", err)
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	insertChunk := fmt.Sprintf(insertChunkTemplate, target.ChunksTable())
+	batch := &pgx.Batch{}
+	for _, chunk := range chunks {
+		chunkMetadataJSON, err := json.Marshal(chunk.Metadata)
+		if err != nil {
+			return false, fmt.Errorf("序列化分块 %d metadata 失败: %w", chunk.Index, err)
+		}
+		batch.Queue(insertChunk, documentID, chunk.Index, chunk.Content, pgvector.NewVector(chunk.Embedding), chunkMetadataJSON)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		return false, fmt.Errorf("批量写入文档块失败: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return true, nil
+}
+
+func scanTableSet(row pgx.Row) (*TableSet, error) {
+	var set TableSet
+	if err := row.Scan(&set.Name, &set.Model, &set.Dimensions, &set.Active, &set.CreatedAt, &set.ActivatedAt); err != nil {
+		return nil, err
+	}
+	return &set, nil
+}
@@ -0,0 +1,284 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// 向量索引类型
+const (
+	VectorIndexHNSW    = "hnsw"
+	VectorIndexIVFFlat = "ivfflat"
+	VectorIndexNone    = "none"
+)
+
+const (
+	// pgvector 的 HNSW 与 IVFFlat 索引最多支持 2000 维 vector、4000 维 halfvec
+	maxIndexedVectorDims  = 2000
+	maxIndexedHalfvecDims = 4000
+
+	maxEfSearch = 1000
+
+	vectorIndexPrefix = "idx_chunks_embedding_"
+)
+
+// VectorIndexOptions 向量索引的构建参数及默认查询参数
+type VectorIndexOptions struct {
+	// Type 为 hnsw、ivfflat 或 none（顺序扫描）
+	Type           string
+	M              int
+	EfConstruction int
+	// Lists 为 0 时按建索引时的分块数推算
+	Lists    int
+	EfSearch int
+	Probes   int
+	// IterativeScan 为 off、relaxed_order 或 strict_order，需要 pgvector 0.8+
+	IterativeScan string
+	// TwoStageDimensions 小于向量维度时只索引前若干维，检索后用完整向量重新打分
+	TwoStageDimensions int
+	// RescoreFactor 为降维索引每个结果多取的候选倍数
+	RescoreFactor int
+}
+
+// ANNParams 单次查询的索引参数，零值字段使用 VectorIndexOptions 中的默认值
+type ANNParams struct {
+	EfSearch int
+	Probes   int
+}
+
+// vectorIndexPlan 描述被索引的向量表达式。查询排序必须使用与索引相同的
+// 表达式才能命中索引，因此索引和查询都由 expr 生成。
+type vectorIndexPlan struct {
+	// exprFormat 以 %s 代表向量列或查询向量
+	exprFormat string
+	opclass    string
+	// reduced 表示只索引了前若干维，排序是近似的
+	reduced       bool
+	rescoreFactor int
+	// nameSuffix 区分两阶段检索的前缀索引，表达式变化时索引随之重建
+	nameSuffix string
+}
+
+// newVectorIndexPlan 按维度选择索引表达式：2000 维以内直接索引 vector，
+// 4000 维以内转换为 halfvec，更高维度取前 4000 维（适用于 Matryoshka
+// 训练的模型，如 Qwen3 Embedding）并在查询时用完整向量重新打分。
+// 配置了两阶段检索时只索引前 TwoStageDimensions 维。
+func newVectorIndexPlan(dimensions int, opts VectorIndexOptions) vectorIndexPlan {
+	var plan vectorIndexPlan
+	switch {
+	case opts.TwoStageDimensions > 0 && opts.TwoStageDimensions < dimensions:
+		plan = prefixIndexPlan(opts.TwoStageDimensions)
+		plan.nameSuffix = fmt.Sprintf("p%d_", opts.TwoStageDimensions)
+	case dimensions <= maxIndexedVectorDims:
+		plan = vectorIndexPlan{exprFormat: "%s", opclass: "vector_cosine_ops"}
+	case dimensions <= maxIndexedHalfvecDims:
+		plan = vectorIndexPlan{
+			exprFormat: fmt.Sprintf("(%%s::halfvec(%d))", dimensions),
+			opclass:    "halfvec_cosine_ops",
+		}
+	default:
+		plan = prefixIndexPlan(maxIndexedHalfvecDims)
+	}
+	plan.rescoreFactor = max(opts.RescoreFactor, 1)
+	return plan
+}
+
+// prefixIndexPlan 索引向量的前 prefix 维。余弦距离与向量长度无关，
+// 截断后无需重新归一化。
+func prefixIndexPlan(prefix int) vectorIndexPlan {
+	if prefix <= maxIndexedVectorDims {
+		return vectorIndexPlan{
+			exprFormat: fmt.Sprintf("(subvector(%%s, 1, %d)::vector(%d))", prefix, prefix),
+			opclass:    "vector_cosine_ops",
+			reduced:    true,
+		}
+	}
+	return vectorIndexPlan{
+		exprFormat: fmt.Sprintf("(subvector(%%s, 1, %d)::halfvec(%d))", prefix, prefix),
+		opclass:    "halfvec_cosine_ops",
+		reduced:    true,
+	}
+}
+
+func (p vectorIndexPlan) expr(operand string) string {
+	return fmt.Sprintf(p.exprFormat, operand)
+}
+
+// orderBy 返回按索引表达式排序的距离，$1 为查询向量
+func (p vectorIndexPlan) orderBy() string {
+	return p.expr("c.embedding") + " <=> " + p.expr("$1::vector")
+}
+
+// candidateLimit 返回内层索引扫描应取的行数
+func (p vectorIndexPlan) candidateLimit(limit int) int {
+	if p.reduced {
+		return limit * p.rescoreFactor
+	}
+	return limit
+}
+
+func vectorIndexName(indexType string, set TableSet, plan vectorIndexPlan) string {
+	return fmt.Sprintf("%s%s_%s%s", vectorIndexPrefix, indexType, plan.nameSuffix, set.Name)
+}
+
+// ensureVectorIndex 创建配置的向量索引并删除表上其他的向量索引（类型或
+// 两阶段前缀不同）。已存在的同名索引保持不变，修改构建参数后需手动删除索引以重建。
+func ensureVectorIndex(ctx context.Context, pool *pgxpool.Pool, set TableSet, opts VectorIndexOptions, plan vectorIndexPlan) error {
+	chunksTable := set.ChunksTable()
+	indexName := ""
+	if opts.Type != VectorIndexNone {
+		indexName = vectorIndexName(opts.Type, set, plan)
+	}
+
+	rows, err := pool.Query(ctx,
+		`SELECT indexname FROM pg_indexes WHERE tablename = $1 AND starts_with(indexname, $2)`,
+		chunksTable, vectorIndexPrefix)
+	if err != nil {
+		return fmt.Errorf("查询向量索引失败: %w", err)
+	}
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("查询向量索引失败: %w", err)
+	}
+	exists := false
+	for _, name := range existing {
+		if name == indexName {
+			exists = true
+			continue
+		}
+		if _, err := pool.Exec(ctx, fmt.Sprintf(`DROP INDEX IF EXISTS %s`, name)); err != nil {
+			return fmt.Errorf("删除向量索引 %s 失败: %w", name, err)
+		}
+		logger.Get().Info("已删除不再使用的向量索引", slog.String("index", name))
+	}
+
+	if opts.Type == VectorIndexNone {
+		logger.Get().Warn("未启用向量索引，向量检索将顺序扫描", slog.String("table", chunksTable))
+		return nil
+	}
+	if exists {
+		logger.Get().Info("向量索引已存在", slog.String("index", indexName))
+		return nil
+	}
+
+	var with string
+	switch opts.Type {
+	case VectorIndexHNSW:
+		with = fmt.Sprintf("m = %d, ef_construction = %d", opts.M, opts.EfConstruction)
+	case VectorIndexIVFFlat:
+		lists := opts.Lists
+		if lists == 0 {
+			var rows int64
+			if err := pool.QueryRow(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM %s`, chunksTable)).Scan(&rows); err != nil {
+				return fmt.Errorf("统计分块数失败: %w", err)
+			}
+			lists = ivfflatLists(rows)
+			if rows == 0 {
+				logger.Get().Warn("分块表为空，IVFFlat 聚类质量较差，导入数据后建议删除索引重建",
+					slog.String("index", indexName))
+			}
+		}
+		with = fmt.Sprintf("lists = %d", lists)
+	default:
+		return fmt.Errorf("未知的向量索引类型 %q", opts.Type)
+	}
+
+	logger.Get().Info("开始创建向量索引，数据量大时耗时较长",
+		slog.String("index", indexName),
+		slog.String("opclass", plan.opclass),
+		slog.Bool("reduced", plan.reduced),
+		slog.String("with", with),
+	)
+	stmt := fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING %s (%s %s) WITH (%s)`,
+		indexName, chunksTable, opts.Type, plan.expr("embedding"), plan.opclass, with)
+	if _, err := pool.Exec(ctx, stmt); err != nil {
+		return fmt.Errorf("创建向量索引失败: %w", err)
+	}
+	return nil
+}
+
+// ivfflatLists 按 pgvector 的建议推算聚类数：百万行以内取 rows/1000，
+// 以上取 sqrt(rows)
+func ivfflatLists(rows int64) int {
+	var lists int
+	if rows <= 1_000_000 {
+		lists = int(rows / 1000)
+	} else {
+		lists = int(math.Sqrt(float64(rows)))
+	}
+	return min(max(lists, 1), 32768)
+}
+
+// annSettings 返回查询前需要在事务内设置的参数
+func (db *PostgresVectorDB) annSettings(params ANNParams, candidates int) [][2]string {
+	opts := db.index
+	var settings [][2]string
+	switch opts.Type {
+	case VectorIndexHNSW:
+		efSearch := opts.EfSearch
+		if params.EfSearch > 0 {
+			efSearch = params.EfSearch
+		}
+		// HNSW 最多返回 ef_search 行，候选数更多时必须同步调大
+		efSearch = min(max(efSearch, candidates), maxEfSearch)
+		settings = append(settings, [2]string{"hnsw.ef_search", strconv.Itoa(efSearch)})
+		if opts.IterativeScan != "" && opts.IterativeScan != "off" {
+			settings = append(settings, [2]string{"hnsw.iterative_scan", opts.IterativeScan})
+		}
+	case VectorIndexIVFFlat:
+		probes := opts.Probes
+		if params.Probes > 0 {
+			probes = params.Probes
+		}
+		settings = append(settings, [2]string{"ivfflat.probes", strconv.Itoa(probes)})
+		// IVFFlat 只支持 relaxed_order
+		if opts.IterativeScan != "" && opts.IterativeScan != "off" {
+			settings = append(settings, [2]string{"ivfflat.iterative_scan", "relaxed_order"})
+		}
+	}
+	return settings
+}
+
+// queryWithANN 在设置了索引查询参数的只读事务中执行查询并扫描结果
+func (db *PostgresVectorDB) queryWithANN(ctx context.Context, params ANNParams, candidates int, query string, args ...interface{}) ([]ChunkSearchResult, error) {
+	settings := db.annSettings(params, candidates)
+	if len(settings) == 0 {
+		rows, err := db.pool.Query(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		return scanChunkSearchResults(rows)
+	}
+
+	tx, err := db.pool.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() { _ = tx.Rollback(context.WithoutCancel(ctx)) }()
+
+	// set_config 的第三个参数为 true 时只在当前事务内生效
+	for _, setting := range settings {
+		if _, err := tx.Exec(ctx, `SELECT set_config($1, $2, true)`, setting[0], setting[1]); err != nil {
+			return nil, fmt.Errorf("设置 %s 失败: %w", setting[0], err)
+		}
+	}
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	results, err := scanChunkSearchResults(rows)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return results, nil
+}
@@ -37,38 +37,125 @@ const (
 	RagServicePreUploadProcedure = "/rag.v1.RagService/PreUpload"
 	// RagServiceUploadPdfProcedure is the fully-qualified name of the RagService's UploadPdf RPC.
 	RagServiceUploadPdfProcedure = "/rag.v1.RagService/UploadPdf"
+	// RagServiceUploadDocumentProcedure is the fully-qualified name of the RagService's UploadDocument
+	// RPC.
+	RagServiceUploadDocumentProcedure = "/rag.v1.RagService/UploadDocument"
+	// RagServiceGetIngestionJobProcedure is the fully-qualified name of the RagService's
+	// GetIngestionJob RPC.
+	RagServiceGetIngestionJobProcedure = "/rag.v1.RagService/GetIngestionJob"
+	// RagServiceListIngestionJobsProcedure is the fully-qualified name of the RagService's
+	// ListIngestionJobs RPC.
+	RagServiceListIngestionJobsProcedure = "/rag.v1.RagService/ListIngestionJobs"
 	// RagServiceGetContextProcedure is the fully-qualified name of the RagService's GetContext RPC.
 	RagServiceGetContextProcedure = "/rag.v1.RagService/GetContext"
+	// RagServiceStreamContextProcedure is the fully-qualified name of the RagService's StreamContext
+	// RPC.
+	RagServiceStreamContextProcedure = "/rag.v1.RagService/StreamContext"
+	// RagServiceSearchProcedure is the fully-qualified name of the RagService's Search RPC.
+	RagServiceSearchProcedure = "/rag.v1.RagService/Search"
+	// RagServiceChatProcedure is the fully-qualified name of the RagService's Chat RPC.
+	RagServiceChatProcedure = "/rag.v1.RagService/Chat"
 	// RagServiceListDocumentsProcedure is the fully-qualified name of the RagService's ListDocuments
 	// RPC.
 	RagServiceListDocumentsProcedure = "/rag.v1.RagService/ListDocuments"
 	// RagServiceDeleteDocumentProcedure is the fully-qualified name of the RagService's DeleteDocument
 	// RPC.
 	RagServiceDeleteDocumentProcedure = "/rag.v1.RagService/DeleteDocument"
+	// RagServiceCreateCollectionProcedure is the fully-qualified name of the RagService's
+	// CreateCollection RPC.
+	RagServiceCreateCollectionProcedure = "/rag.v1.RagService/CreateCollection"
+	// RagServiceListCollectionsProcedure is the fully-qualified name of the RagService's
+	// ListCollections RPC.
+	RagServiceListCollectionsProcedure = "/rag.v1.RagService/ListCollections"
+	// RagServiceDeleteCollectionProcedure is the fully-qualified name of the RagService's
+	// DeleteCollection RPC.
+	RagServiceDeleteCollectionProcedure = "/rag.v1.RagService/DeleteCollection"
+	// RagServiceStartReindexProcedure is the fully-qualified name of the RagService's StartReindex RPC.
+	RagServiceStartReindexProcedure = "/rag.v1.RagService/StartReindex"
+	// RagServiceGetReindexJobProcedure is the fully-qualified name of the RagService's GetReindexJob
+	// RPC.
+	RagServiceGetReindexJobProcedure = "/rag.v1.RagService/GetReindexJob"
+	// RagServiceCutoverReindexProcedure is the fully-qualified name of the RagService's CutoverReindex
+	// RPC.
+	RagServiceCutoverReindexProcedure = "/rag.v1.RagService/CutoverReindex"
+	// RagServiceRollbackReindexProcedure is the fully-qualified name of the RagService's
+	// RollbackReindex RPC.
+	RagServiceRollbackReindexProcedure = "/rag.v1.RagService/RollbackReindex"
+	// RagServiceListTableSetsProcedure is the fully-qualified name of the RagService's ListTableSets
+	// RPC.
+	RagServiceListTableSetsProcedure = "/rag.v1.RagService/ListTableSets"
+	// RagServiceDeleteTableSetProcedure is the fully-qualified name of the RagService's DeleteTableSet
+	// RPC.
+	RagServiceDeleteTableSetProcedure = "/rag.v1.RagService/DeleteTableSet"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	ragServiceServiceDescriptor              = v1.File_rag_v1_rag_proto.Services().ByName("RagService")
-	ragServicePreUploadMethodDescriptor      = ragServiceServiceDescriptor.Methods().ByName("PreUpload")
-	ragServiceUploadPdfMethodDescriptor      = ragServiceServiceDescriptor.Methods().ByName("UploadPdf")
-	ragServiceGetContextMethodDescriptor     = ragServiceServiceDescriptor.Methods().ByName("GetContext")
-	ragServiceListDocumentsMethodDescriptor  = ragServiceServiceDescriptor.Methods().ByName("ListDocuments")
-	ragServiceDeleteDocumentMethodDescriptor = ragServiceServiceDescriptor.Methods().ByName("DeleteDocument")
+	ragServiceServiceDescriptor                 = v1.File_rag_v1_rag_proto.Services().ByName("RagService")
+	ragServicePreUploadMethodDescriptor         = ragServiceServiceDescriptor.Methods().ByName("PreUpload")
+	ragServiceUploadPdfMethodDescriptor         = ragServiceServiceDescriptor.Methods().ByName("UploadPdf")
+	ragServiceUploadDocumentMethodDescriptor    = ragServiceServiceDescriptor.Methods().ByName("UploadDocument")
+	ragServiceGetIngestionJobMethodDescriptor   = ragServiceServiceDescriptor.Methods().ByName("GetIngestionJob")
+	ragServiceListIngestionJobsMethodDescriptor = ragServiceServiceDescriptor.Methods().ByName("ListIngestionJobs")
+	ragServiceGetContextMethodDescriptor        = ragServiceServiceDescriptor.Methods().ByName("GetContext")
+	ragServiceStreamContextMethodDescriptor     = ragServiceServiceDescriptor.Methods().ByName("StreamContext")
+	ragServiceSearchMethodDescriptor            = ragServiceServiceDescriptor.Methods().ByName("Search")
+	ragServiceChatMethodDescriptor              = ragServiceServiceDescriptor.Methods().ByName("Chat")
+	ragServiceListDocumentsMethodDescriptor     = ragServiceServiceDescriptor.Methods().ByName("ListDocuments")
+	ragServiceDeleteDocumentMethodDescriptor    = ragServiceServiceDescriptor.Methods().ByName("DeleteDocument")
+	ragServiceCreateCollectionMethodDescriptor  = ragServiceServiceDescriptor.Methods().ByName("CreateCollection")
+	ragServiceListCollectionsMethodDescriptor   = ragServiceServiceDescriptor.Methods().ByName("ListCollections")
+	ragServiceDeleteCollectionMethodDescriptor  = ragServiceServiceDescriptor.Methods().ByName("DeleteCollection")
+	ragServiceStartReindexMethodDescriptor      = ragServiceServiceDescriptor.Methods().ByName("StartReindex")
+	ragServiceGetReindexJobMethodDescriptor     = ragServiceServiceDescriptor.Methods().ByName("GetReindexJob")
+	ragServiceCutoverReindexMethodDescriptor    = ragServiceServiceDescriptor.Methods().ByName("CutoverReindex")
+	ragServiceRollbackReindexMethodDescriptor   = ragServiceServiceDescriptor.Methods().ByName("RollbackReindex")
+	ragServiceListTableSetsMethodDescriptor     = ragServiceServiceDescriptor.Methods().ByName("ListTableSets")
+	ragServiceDeleteTableSetMethodDescriptor    = ragServiceServiceDescriptor.Methods().ByName("DeleteTableSet")
 )
 
 // RagServiceClient is a client for the rag.v1.RagService service.
 type RagServiceClient interface {
 	// 预上传接口，生成文件上传的预签名URL
 	PreUpload(context.Context, *connect.Request[v1.PreUploadRequest]) (*connect.Response[v1.PreUploadResponse], error)
-	// 上传PDF文件并进行处理
+	// 提交PDF摄取任务，立即返回任务ID，由后台worker异步处理
 	UploadPdf(context.Context, *connect.Request[v1.UploadPdfRequest]) (*connect.Response[v1.UploadPdfResponse], error)
+	// 提交任意支持格式（PDF、Markdown、文本、HTML、DOCX）的摄取任务
+	UploadDocument(context.Context, *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error)
+	// 查询摄取任务状态
+	GetIngestionJob(context.Context, *connect.Request[v1.GetIngestionJobRequest]) (*connect.Response[v1.GetIngestionJobResponse], error)
+	// 列出摄取任务
+	ListIngestionJobs(context.Context, *connect.Request[v1.ListIngestionJobsRequest]) (*connect.Response[v1.ListIngestionJobsResponse], error)
 	// 根据查询获取相关上下文
 	GetContext(context.Context, *connect.Request[v1.GetContextRequest]) (*connect.Response[v1.GetContextResponse], error)
+	// 流式获取上下文，逐阶段推送检索进度并逐字输出总结
+	StreamContext(context.Context, *connect.Request[v1.StreamContextRequest]) (*connect.ServerStreamForClient[v1.StreamContextResponse], error)
+	// 纯检索接口，仅返回排序后的分块，不调用大模型生成总结
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
+	// 多轮对话，服务端按会话保存历史并改写追问
+	Chat(context.Context, *connect.Request[v1.ChatRequest]) (*connect.Response[v1.ChatResponse], error)
 	// 列出已上传文档
 	ListDocuments(context.Context, *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error)
 	// 删除文档（同时删除关联分块）
 	DeleteDocument(context.Context, *connect.Request[v1.DeleteDocumentRequest]) (*connect.Response[v1.DeleteDocumentResponse], error)
+	// 创建集合（知识库）
+	CreateCollection(context.Context, *connect.Request[v1.CreateCollectionRequest]) (*connect.Response[v1.CreateCollectionResponse], error)
+	// 列出集合
+	ListCollections(context.Context, *connect.Request[v1.ListCollectionsRequest]) (*connect.Response[v1.ListCollectionsResponse], error)
+	// 删除集合（同时删除其全部文档和分块）
+	DeleteCollection(context.Context, *connect.Request[v1.DeleteCollectionRequest]) (*connect.Response[v1.DeleteCollectionResponse], error)
+	// 启动重建索引任务，用新的向量模型把全部文档写入新表集
+	StartReindex(context.Context, *connect.Request[v1.StartReindexRequest]) (*connect.Response[v1.StartReindexResponse], error)
+	// 查询重建索引任务状态
+	GetReindexJob(context.Context, *connect.Request[v1.GetReindexJobRequest]) (*connect.Response[v1.GetReindexJobResponse], error)
+	// 把已就绪的重建任务原子切换为生效表集
+	CutoverReindex(context.Context, *connect.Request[v1.CutoverReindexRequest]) (*connect.Response[v1.CutoverReindexResponse], error)
+	// 回滚已切换的重建任务，重新启用原表集
+	RollbackReindex(context.Context, *connect.Request[v1.RollbackReindexRequest]) (*connect.Response[v1.RollbackReindexResponse], error)
+	// 列出全部表集
+	ListTableSets(context.Context, *connect.Request[v1.ListTableSetsRequest]) (*connect.Response[v1.ListTableSetsResponse], error)
+	// 删除未生效的表集
+	DeleteTableSet(context.Context, *connect.Request[v1.DeleteTableSetRequest]) (*connect.Response[v1.DeleteTableSetResponse], error)
 }
 
 // NewRagServiceClient constructs a client for the rag.v1.RagService service. By default, it uses
@@ -93,12 +180,48 @@ func NewRagServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(ragServiceUploadPdfMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		uploadDocument: connect.NewClient[v1.UploadDocumentRequest, v1.UploadDocumentResponse](
+			httpClient,
+			baseURL+RagServiceUploadDocumentProcedure,
+			connect.WithSchema(ragServiceUploadDocumentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getIngestionJob: connect.NewClient[v1.GetIngestionJobRequest, v1.GetIngestionJobResponse](
+			httpClient,
+			baseURL+RagServiceGetIngestionJobProcedure,
+			connect.WithSchema(ragServiceGetIngestionJobMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listIngestionJobs: connect.NewClient[v1.ListIngestionJobsRequest, v1.ListIngestionJobsResponse](
+			httpClient,
+			baseURL+RagServiceListIngestionJobsProcedure,
+			connect.WithSchema(ragServiceListIngestionJobsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getContext: connect.NewClient[v1.GetContextRequest, v1.GetContextResponse](
 			httpClient,
 			baseURL+RagServiceGetContextProcedure,
 			connect.WithSchema(ragServiceGetContextMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		streamContext: connect.NewClient[v1.StreamContextRequest, v1.StreamContextResponse](
+			httpClient,
+			baseURL+RagServiceStreamContextProcedure,
+			connect.WithSchema(ragServiceStreamContextMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		search: connect.NewClient[v1.SearchRequest, v1.SearchResponse](
+			httpClient,
+			baseURL+RagServiceSearchProcedure,
+			connect.WithSchema(ragServiceSearchMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		chat: connect.NewClient[v1.ChatRequest, v1.ChatResponse](
+			httpClient,
+			baseURL+RagServiceChatProcedure,
+			connect.WithSchema(ragServiceChatMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listDocuments: connect.NewClient[v1.ListDocumentsRequest, v1.ListDocumentsResponse](
 			httpClient,
 			baseURL+RagServiceListDocumentsProcedure,
@@ -111,16 +234,85 @@ func NewRagServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(ragServiceDeleteDocumentMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createCollection: connect.NewClient[v1.CreateCollectionRequest, v1.CreateCollectionResponse](
+			httpClient,
+			baseURL+RagServiceCreateCollectionProcedure,
+			connect.WithSchema(ragServiceCreateCollectionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listCollections: connect.NewClient[v1.ListCollectionsRequest, v1.ListCollectionsResponse](
+			httpClient,
+			baseURL+RagServiceListCollectionsProcedure,
+			connect.WithSchema(ragServiceListCollectionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCollection: connect.NewClient[v1.DeleteCollectionRequest, v1.DeleteCollectionResponse](
+			httpClient,
+			baseURL+RagServiceDeleteCollectionProcedure,
+			connect.WithSchema(ragServiceDeleteCollectionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		startReindex: connect.NewClient[v1.StartReindexRequest, v1.StartReindexResponse](
+			httpClient,
+			baseURL+RagServiceStartReindexProcedure,
+			connect.WithSchema(ragServiceStartReindexMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getReindexJob: connect.NewClient[v1.GetReindexJobRequest, v1.GetReindexJobResponse](
+			httpClient,
+			baseURL+RagServiceGetReindexJobProcedure,
+			connect.WithSchema(ragServiceGetReindexJobMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		cutoverReindex: connect.NewClient[v1.CutoverReindexRequest, v1.CutoverReindexResponse](
+			httpClient,
+			baseURL+RagServiceCutoverReindexProcedure,
+			connect.WithSchema(ragServiceCutoverReindexMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackReindex: connect.NewClient[v1.RollbackReindexRequest, v1.RollbackReindexResponse](
+			httpClient,
+			baseURL+RagServiceRollbackReindexProcedure,
+			connect.WithSchema(ragServiceRollbackReindexMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTableSets: connect.NewClient[v1.ListTableSetsRequest, v1.ListTableSetsResponse](
+			httpClient,
+			baseURL+RagServiceListTableSetsProcedure,
+			connect.WithSchema(ragServiceListTableSetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTableSet: connect.NewClient[v1.DeleteTableSetRequest, v1.DeleteTableSetResponse](
+			httpClient,
+			baseURL+RagServiceDeleteTableSetProcedure,
+			connect.WithSchema(ragServiceDeleteTableSetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // ragServiceClient implements RagServiceClient.
 type ragServiceClient struct {
-	preUpload      *connect.Client[v1.PreUploadRequest, v1.PreUploadResponse]
-	uploadPdf      *connect.Client[v1.UploadPdfRequest, v1.UploadPdfResponse]
-	getContext     *connect.Client[v1.GetContextRequest, v1.GetContextResponse]
-	listDocuments  *connect.Client[v1.ListDocumentsRequest, v1.ListDocumentsResponse]
-	deleteDocument *connect.Client[v1.DeleteDocumentRequest, v1.DeleteDocumentResponse]
+	preUpload         *connect.Client[v1.PreUploadRequest, v1.PreUploadResponse]
+	uploadPdf         *connect.Client[v1.UploadPdfRequest, v1.UploadPdfResponse]
+	uploadDocument    *connect.Client[v1.UploadDocumentRequest, v1.UploadDocumentResponse]
+	getIngestionJob   *connect.Client[v1.GetIngestionJobRequest, v1.GetIngestionJobResponse]
+	listIngestionJobs *connect.Client[v1.ListIngestionJobsRequest, v1.ListIngestionJobsResponse]
+	getContext        *connect.Client[v1.GetContextRequest, v1.GetContextResponse]
+	streamContext     *connect.Client[v1.StreamContextRequest, v1.StreamContextResponse]
+	search            *connect.Client[v1.SearchRequest, v1.SearchResponse]
+	chat              *connect.Client[v1.ChatRequest, v1.ChatResponse]
+	listDocuments     *connect.Client[v1.ListDocumentsRequest, v1.ListDocumentsResponse]
+	deleteDocument    *connect.Client[v1.DeleteDocumentRequest, v1.DeleteDocumentResponse]
+	createCollection  *connect.Client[v1.CreateCollectionRequest, v1.CreateCollectionResponse]
+	listCollections   *connect.Client[v1.ListCollectionsRequest, v1.ListCollectionsResponse]
+	deleteCollection  *connect.Client[v1.DeleteCollectionRequest, v1.DeleteCollectionResponse]
+	startReindex      *connect.Client[v1.StartReindexRequest, v1.StartReindexResponse]
+	getReindexJob     *connect.Client[v1.GetReindexJobRequest, v1.GetReindexJobResponse]
+	cutoverReindex    *connect.Client[v1.CutoverReindexRequest, v1.CutoverReindexResponse]
+	rollbackReindex   *connect.Client[v1.RollbackReindexRequest, v1.RollbackReindexResponse]
+	listTableSets     *connect.Client[v1.ListTableSetsRequest, v1.ListTableSetsResponse]
+	deleteTableSet    *connect.Client[v1.DeleteTableSetRequest, v1.DeleteTableSetResponse]
 }
 
 // PreUpload calls rag.v1.RagService.PreUpload.
@@ -133,11 +325,41 @@ func (c *ragServiceClient) UploadPdf(ctx context.Context, req *connect.Request[v
 	return c.uploadPdf.CallUnary(ctx, req)
 }
 
+// UploadDocument calls rag.v1.RagService.UploadDocument.
+func (c *ragServiceClient) UploadDocument(ctx context.Context, req *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error) {
+	return c.uploadDocument.CallUnary(ctx, req)
+}
+
+// GetIngestionJob calls rag.v1.RagService.GetIngestionJob.
+func (c *ragServiceClient) GetIngestionJob(ctx context.Context, req *connect.Request[v1.GetIngestionJobRequest]) (*connect.Response[v1.GetIngestionJobResponse], error) {
+	return c.getIngestionJob.CallUnary(ctx, req)
+}
+
+// ListIngestionJobs calls rag.v1.RagService.ListIngestionJobs.
+func (c *ragServiceClient) ListIngestionJobs(ctx context.Context, req *connect.Request[v1.ListIngestionJobsRequest]) (*connect.Response[v1.ListIngestionJobsResponse], error) {
+	return c.listIngestionJobs.CallUnary(ctx, req)
+}
+
 // GetContext calls rag.v1.RagService.GetContext.
 func (c *ragServiceClient) GetContext(ctx context.Context, req *connect.Request[v1.GetContextRequest]) (*connect.Response[v1.GetContextResponse], error) {
 	return c.getContext.CallUnary(ctx, req)
 }
 
+// StreamContext calls rag.v1.RagService.StreamContext.
+func (c *ragServiceClient) StreamContext(ctx context.Context, req *connect.Request[v1.StreamContextRequest]) (*connect.ServerStreamForClient[v1.StreamContextResponse], error) {
+	return c.streamContext.CallServerStream(ctx, req)
+}
+
+// Search calls rag.v1.RagService.Search.
+func (c *ragServiceClient) Search(ctx context.Context, req *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return c.search.CallUnary(ctx, req)
+}
+
+// Chat calls rag.v1.RagService.Chat.
+func (c *ragServiceClient) Chat(ctx context.Context, req *connect.Request[v1.ChatRequest]) (*connect.Response[v1.ChatResponse], error) {
+	return c.chat.CallUnary(ctx, req)
+}
+
 // ListDocuments calls rag.v1.RagService.ListDocuments.
 func (c *ragServiceClient) ListDocuments(ctx context.Context, req *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error) {
 	return c.listDocuments.CallUnary(ctx, req)
@@ -148,18 +370,93 @@ func (c *ragServiceClient) DeleteDocument(ctx context.Context, req *connect.Requ
 	return c.deleteDocument.CallUnary(ctx, req)
 }
 
+// CreateCollection calls rag.v1.RagService.CreateCollection.
+func (c *ragServiceClient) CreateCollection(ctx context.Context, req *connect.Request[v1.CreateCollectionRequest]) (*connect.Response[v1.CreateCollectionResponse], error) {
+	return c.createCollection.CallUnary(ctx, req)
+}
+
+// ListCollections calls rag.v1.RagService.ListCollections.
+func (c *ragServiceClient) ListCollections(ctx context.Context, req *connect.Request[v1.ListCollectionsRequest]) (*connect.Response[v1.ListCollectionsResponse], error) {
+	return c.listCollections.CallUnary(ctx, req)
+}
+
+// DeleteCollection calls rag.v1.RagService.DeleteCollection.
+func (c *ragServiceClient) DeleteCollection(ctx context.Context, req *connect.Request[v1.DeleteCollectionRequest]) (*connect.Response[v1.DeleteCollectionResponse], error) {
+	return c.deleteCollection.CallUnary(ctx, req)
+}
+
+// StartReindex calls rag.v1.RagService.StartReindex.
+func (c *ragServiceClient) StartReindex(ctx context.Context, req *connect.Request[v1.StartReindexRequest]) (*connect.Response[v1.StartReindexResponse], error) {
+	return c.startReindex.CallUnary(ctx, req)
+}
+
+// GetReindexJob calls rag.v1.RagService.GetReindexJob.
+func (c *ragServiceClient) GetReindexJob(ctx context.Context, req *connect.Request[v1.GetReindexJobRequest]) (*connect.Response[v1.GetReindexJobResponse], error) {
+	return c.getReindexJob.CallUnary(ctx, req)
+}
+
+// CutoverReindex calls rag.v1.RagService.CutoverReindex.
+func (c *ragServiceClient) CutoverReindex(ctx context.Context, req *connect.Request[v1.CutoverReindexRequest]) (*connect.Response[v1.CutoverReindexResponse], error) {
+	return c.cutoverReindex.CallUnary(ctx, req)
+}
+
+// RollbackReindex calls rag.v1.RagService.RollbackReindex.
+func (c *ragServiceClient) RollbackReindex(ctx context.Context, req *connect.Request[v1.RollbackReindexRequest]) (*connect.Response[v1.RollbackReindexResponse], error) {
+	return c.rollbackReindex.CallUnary(ctx, req)
+}
+
+// ListTableSets calls rag.v1.RagService.ListTableSets.
+func (c *ragServiceClient) ListTableSets(ctx context.Context, req *connect.Request[v1.ListTableSetsRequest]) (*connect.Response[v1.ListTableSetsResponse], error) {
+	return c.listTableSets.CallUnary(ctx, req)
+}
+
+// DeleteTableSet calls rag.v1.RagService.DeleteTableSet.
+func (c *ragServiceClient) DeleteTableSet(ctx context.Context, req *connect.Request[v1.DeleteTableSetRequest]) (*connect.Response[v1.DeleteTableSetResponse], error) {
+	return c.deleteTableSet.CallUnary(ctx, req)
+}
+
 // RagServiceHandler is an implementation of the rag.v1.RagService service.
 type RagServiceHandler interface {
 	// 预上传接口，生成文件上传的预签名URL
 	PreUpload(context.Context, *connect.Request[v1.PreUploadRequest]) (*connect.Response[v1.PreUploadResponse], error)
-	// 上传PDF文件并进行处理
+	// 提交PDF摄取任务，立即返回任务ID，由后台worker异步处理
 	UploadPdf(context.Context, *connect.Request[v1.UploadPdfRequest]) (*connect.Response[v1.UploadPdfResponse], error)
+	// 提交任意支持格式（PDF、Markdown、文本、HTML、DOCX）的摄取任务
+	UploadDocument(context.Context, *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error)
+	// 查询摄取任务状态
+	GetIngestionJob(context.Context, *connect.Request[v1.GetIngestionJobRequest]) (*connect.Response[v1.GetIngestionJobResponse], error)
+	// 列出摄取任务
+	ListIngestionJobs(context.Context, *connect.Request[v1.ListIngestionJobsRequest]) (*connect.Response[v1.ListIngestionJobsResponse], error)
 	// 根据查询获取相关上下文
 	GetContext(context.Context, *connect.Request[v1.GetContextRequest]) (*connect.Response[v1.GetContextResponse], error)
+	// 流式获取上下文，逐阶段推送检索进度并逐字输出总结
+	StreamContext(context.Context, *connect.Request[v1.StreamContextRequest], *connect.ServerStream[v1.StreamContextResponse]) error
+	// 纯检索接口，仅返回排序后的分块，不调用大模型生成总结
+	Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error)
+	// 多轮对话，服务端按会话保存历史并改写追问
+	Chat(context.Context, *connect.Request[v1.ChatRequest]) (*connect.Response[v1.ChatResponse], error)
 	// 列出已上传文档
 	ListDocuments(context.Context, *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error)
 	// 删除文档（同时删除关联分块）
 	DeleteDocument(context.Context, *connect.Request[v1.DeleteDocumentRequest]) (*connect.Response[v1.DeleteDocumentResponse], error)
+	// 创建集合（知识库）
+	CreateCollection(context.Context, *connect.Request[v1.CreateCollectionRequest]) (*connect.Response[v1.CreateCollectionResponse], error)
+	// 列出集合
+	ListCollections(context.Context, *connect.Request[v1.ListCollectionsRequest]) (*connect.Response[v1.ListCollectionsResponse], error)
+	// 删除集合（同时删除其全部文档和分块）
+	DeleteCollection(context.Context, *connect.Request[v1.DeleteCollectionRequest]) (*connect.Response[v1.DeleteCollectionResponse], error)
+	// 启动重建索引任务，用新的向量模型把全部文档写入新表集
+	StartReindex(context.Context, *connect.Request[v1.StartReindexRequest]) (*connect.Response[v1.StartReindexResponse], error)
+	// 查询重建索引任务状态
+	GetReindexJob(context.Context, *connect.Request[v1.GetReindexJobRequest]) (*connect.Response[v1.GetReindexJobResponse], error)
+	// 把已就绪的重建任务原子切换为生效表集
+	CutoverReindex(context.Context, *connect.Request[v1.CutoverReindexRequest]) (*connect.Response[v1.CutoverReindexResponse], error)
+	// 回滚已切换的重建任务，重新启用原表集
+	RollbackReindex(context.Context, *connect.Request[v1.RollbackReindexRequest]) (*connect.Response[v1.RollbackReindexResponse], error)
+	// 列出全部表集
+	ListTableSets(context.Context, *connect.Request[v1.ListTableSetsRequest]) (*connect.Response[v1.ListTableSetsResponse], error)
+	// 删除未生效的表集
+	DeleteTableSet(context.Context, *connect.Request[v1.DeleteTableSetRequest]) (*connect.Response[v1.DeleteTableSetResponse], error)
 }
 
 // NewRagServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -180,12 +477,48 @@ func NewRagServiceHandler(svc RagServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(ragServiceUploadPdfMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	ragServiceUploadDocumentHandler := connect.NewUnaryHandler(
+		RagServiceUploadDocumentProcedure,
+		svc.UploadDocument,
+		connect.WithSchema(ragServiceUploadDocumentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceGetIngestionJobHandler := connect.NewUnaryHandler(
+		RagServiceGetIngestionJobProcedure,
+		svc.GetIngestionJob,
+		connect.WithSchema(ragServiceGetIngestionJobMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceListIngestionJobsHandler := connect.NewUnaryHandler(
+		RagServiceListIngestionJobsProcedure,
+		svc.ListIngestionJobs,
+		connect.WithSchema(ragServiceListIngestionJobsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	ragServiceGetContextHandler := connect.NewUnaryHandler(
 		RagServiceGetContextProcedure,
 		svc.GetContext,
 		connect.WithSchema(ragServiceGetContextMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	ragServiceStreamContextHandler := connect.NewServerStreamHandler(
+		RagServiceStreamContextProcedure,
+		svc.StreamContext,
+		connect.WithSchema(ragServiceStreamContextMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceSearchHandler := connect.NewUnaryHandler(
+		RagServiceSearchProcedure,
+		svc.Search,
+		connect.WithSchema(ragServiceSearchMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceChatHandler := connect.NewUnaryHandler(
+		RagServiceChatProcedure,
+		svc.Chat,
+		connect.WithSchema(ragServiceChatMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	ragServiceListDocumentsHandler := connect.NewUnaryHandler(
 		RagServiceListDocumentsProcedure,
 		svc.ListDocuments,
@@ -198,18 +531,102 @@ func NewRagServiceHandler(svc RagServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(ragServiceDeleteDocumentMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	ragServiceCreateCollectionHandler := connect.NewUnaryHandler(
+		RagServiceCreateCollectionProcedure,
+		svc.CreateCollection,
+		connect.WithSchema(ragServiceCreateCollectionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceListCollectionsHandler := connect.NewUnaryHandler(
+		RagServiceListCollectionsProcedure,
+		svc.ListCollections,
+		connect.WithSchema(ragServiceListCollectionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceDeleteCollectionHandler := connect.NewUnaryHandler(
+		RagServiceDeleteCollectionProcedure,
+		svc.DeleteCollection,
+		connect.WithSchema(ragServiceDeleteCollectionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceStartReindexHandler := connect.NewUnaryHandler(
+		RagServiceStartReindexProcedure,
+		svc.StartReindex,
+		connect.WithSchema(ragServiceStartReindexMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceGetReindexJobHandler := connect.NewUnaryHandler(
+		RagServiceGetReindexJobProcedure,
+		svc.GetReindexJob,
+		connect.WithSchema(ragServiceGetReindexJobMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceCutoverReindexHandler := connect.NewUnaryHandler(
+		RagServiceCutoverReindexProcedure,
+		svc.CutoverReindex,
+		connect.WithSchema(ragServiceCutoverReindexMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceRollbackReindexHandler := connect.NewUnaryHandler(
+		RagServiceRollbackReindexProcedure,
+		svc.RollbackReindex,
+		connect.WithSchema(ragServiceRollbackReindexMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceListTableSetsHandler := connect.NewUnaryHandler(
+		RagServiceListTableSetsProcedure,
+		svc.ListTableSets,
+		connect.WithSchema(ragServiceListTableSetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	ragServiceDeleteTableSetHandler := connect.NewUnaryHandler(
+		RagServiceDeleteTableSetProcedure,
+		svc.DeleteTableSet,
+		connect.WithSchema(ragServiceDeleteTableSetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/rag.v1.RagService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RagServicePreUploadProcedure:
 			ragServicePreUploadHandler.ServeHTTP(w, r)
 		case RagServiceUploadPdfProcedure:
 			ragServiceUploadPdfHandler.ServeHTTP(w, r)
+		case RagServiceUploadDocumentProcedure:
+			ragServiceUploadDocumentHandler.ServeHTTP(w, r)
+		case RagServiceGetIngestionJobProcedure:
+			ragServiceGetIngestionJobHandler.ServeHTTP(w, r)
+		case RagServiceListIngestionJobsProcedure:
+			ragServiceListIngestionJobsHandler.ServeHTTP(w, r)
 		case RagServiceGetContextProcedure:
 			ragServiceGetContextHandler.ServeHTTP(w, r)
+		case RagServiceStreamContextProcedure:
+			ragServiceStreamContextHandler.ServeHTTP(w, r)
+		case RagServiceSearchProcedure:
+			ragServiceSearchHandler.ServeHTTP(w, r)
+		case RagServiceChatProcedure:
+			ragServiceChatHandler.ServeHTTP(w, r)
 		case RagServiceListDocumentsProcedure:
 			ragServiceListDocumentsHandler.ServeHTTP(w, r)
 		case RagServiceDeleteDocumentProcedure:
 			ragServiceDeleteDocumentHandler.ServeHTTP(w, r)
+		case RagServiceCreateCollectionProcedure:
+			ragServiceCreateCollectionHandler.ServeHTTP(w, r)
+		case RagServiceListCollectionsProcedure:
+			ragServiceListCollectionsHandler.ServeHTTP(w, r)
+		case RagServiceDeleteCollectionProcedure:
+			ragServiceDeleteCollectionHandler.ServeHTTP(w, r)
+		case RagServiceStartReindexProcedure:
+			ragServiceStartReindexHandler.ServeHTTP(w, r)
+		case RagServiceGetReindexJobProcedure:
+			ragServiceGetReindexJobHandler.ServeHTTP(w, r)
+		case RagServiceCutoverReindexProcedure:
+			ragServiceCutoverReindexHandler.ServeHTTP(w, r)
+		case RagServiceRollbackReindexProcedure:
+			ragServiceRollbackReindexHandler.ServeHTTP(w, r)
+		case RagServiceListTableSetsProcedure:
+			ragServiceListTableSetsHandler.ServeHTTP(w, r)
+		case RagServiceDeleteTableSetProcedure:
+			ragServiceDeleteTableSetHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -227,10 +644,34 @@ func (UnimplementedRagServiceHandler) UploadPdf(context.Context, *connect.Reques
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.UploadPdf is not implemented"))
 }
 
+func (UnimplementedRagServiceHandler) UploadDocument(context.Context, *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.UploadDocument is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) GetIngestionJob(context.Context, *connect.Request[v1.GetIngestionJobRequest]) (*connect.Response[v1.GetIngestionJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.GetIngestionJob is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) ListIngestionJobs(context.Context, *connect.Request[v1.ListIngestionJobsRequest]) (*connect.Response[v1.ListIngestionJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.ListIngestionJobs is not implemented"))
+}
+
 func (UnimplementedRagServiceHandler) GetContext(context.Context, *connect.Request[v1.GetContextRequest]) (*connect.Response[v1.GetContextResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.GetContext is not implemented"))
 }
 
+func (UnimplementedRagServiceHandler) StreamContext(context.Context, *connect.Request[v1.StreamContextRequest], *connect.ServerStream[v1.StreamContextResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.StreamContext is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) Search(context.Context, *connect.Request[v1.SearchRequest]) (*connect.Response[v1.SearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.Search is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) Chat(context.Context, *connect.Request[v1.ChatRequest]) (*connect.Response[v1.ChatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.Chat is not implemented"))
+}
+
 func (UnimplementedRagServiceHandler) ListDocuments(context.Context, *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.ListDocuments is not implemented"))
 }
@@ -238,3 +679,39 @@ func (UnimplementedRagServiceHandler) ListDocuments(context.Context, *connect.Re
 func (UnimplementedRagServiceHandler) DeleteDocument(context.Context, *connect.Request[v1.DeleteDocumentRequest]) (*connect.Response[v1.DeleteDocumentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.DeleteDocument is not implemented"))
 }
+
+func (UnimplementedRagServiceHandler) CreateCollection(context.Context, *connect.Request[v1.CreateCollectionRequest]) (*connect.Response[v1.CreateCollectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.CreateCollection is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) ListCollections(context.Context, *connect.Request[v1.ListCollectionsRequest]) (*connect.Response[v1.ListCollectionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.ListCollections is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) DeleteCollection(context.Context, *connect.Request[v1.DeleteCollectionRequest]) (*connect.Response[v1.DeleteCollectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.DeleteCollection is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) StartReindex(context.Context, *connect.Request[v1.StartReindexRequest]) (*connect.Response[v1.StartReindexResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.StartReindex is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) GetReindexJob(context.Context, *connect.Request[v1.GetReindexJobRequest]) (*connect.Response[v1.GetReindexJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.GetReindexJob is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) CutoverReindex(context.Context, *connect.Request[v1.CutoverReindexRequest]) (*connect.Response[v1.CutoverReindexResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.CutoverReindex is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) RollbackReindex(context.Context, *connect.Request[v1.RollbackReindexRequest]) (*connect.Response[v1.RollbackReindexResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.RollbackReindex is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) ListTableSets(context.Context, *connect.Request[v1.ListTableSetsRequest]) (*connect.Response[v1.ListTableSetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.ListTableSets is not implemented"))
+}
+
+func (UnimplementedRagServiceHandler) DeleteTableSet(context.Context, *connect.Request[v1.DeleteTableSetRequest]) (*connect.Response[v1.DeleteTableSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rag.v1.RagService.DeleteTableSet is not implemented"))
+}
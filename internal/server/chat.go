@@ -0,0 +1,243 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
+	"github.com/hsn0918/rag/pkg/clients/openai"
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/hsn0918/rag/pkg/prompts"
+	"github.com/hsn0918/rag/pkg/redis"
+	pkgutils "github.com/hsn0918/rag/pkg/utils"
+)
+
+const (
+	// maxSessionTurns bounds how many messages a session keeps in Redis.
+	maxSessionTurns = 20
+	// promptHistoryTurns is how many of the most recent messages are fed
+	// back into the rewrite and summary prompts.
+	promptHistoryTurns = 6
+	// historyTurnMaxBytes truncates each replayed message so long XML
+	// answers do not crowd out the retrieved context.
+	historyTurnMaxBytes = 1500
+)
+
+// chatSession is the conversation state stored under the session key.
+type chatSession struct {
+	Turns     []chatTurn `json:"turns"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type chatTurn struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Chat answers one turn of a multi-turn conversation.
+//
+// History is kept server-side in Redis keyed by session_id. Each turn:
+//  1. Loads the session, creating a new one when session_id is empty
+//  2. Rewrites the message into a standalone query using prior turns
+//  3. Runs the GetContext retrieval stages with the standalone query
+//  4. Generates the answer with prior turns included in the prompt
+//  5. Appends the exchange to the session
+func (s *RagServer) Chat(
+	ctx context.Context,
+	req *connect.Request[ragv1.ChatRequest],
+) (*connect.Response[ragv1.ChatResponse], error) {
+	startTime := time.Now()
+	message := req.Msg.GetMessage()
+
+	if message == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("message is required"))
+	}
+
+	sessionID := req.Msg.GetSessionId()
+	var session chatSession
+	if sessionID == "" {
+		sessionID = uuid.NewString()
+	} else if err := s.Cache.GetSession(ctx, sessionID, &session); err != nil {
+		logger.Get().Error("加载会话失败", slog.String("session_id", sessionID), slog.Any("error", err))
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to load session: %w", err))
+	}
+
+	logger.Get().Info("开始处理对话请求",
+		slog.String("session_id", sessionID),
+		slog.String("message", message),
+		slog.Int("history_turns", len(session.Turns)),
+		slog.String("request_id", req.Header().Get("X-Request-ID")),
+	)
+
+	history := session.recentTurns()
+	standaloneQuery := s.rewriteQuery(ctx, history, message)
+
+	filter, collection, err := s.queryScope(ctx, req.Msg.GetCollectionId(), nil)
+	if err != nil {
+		return nil, err
+	}
+	stage := &contextStages{query: standaloneQuery, filter: filter, collection: collection}
+	if err := s.runKeywordStage(ctx, stage); err != nil {
+		return nil, err
+	}
+	if err := s.runEmbeddingStage(ctx, stage); err != nil {
+		return nil, err
+	}
+	if err := s.runSearchStage(ctx, stage); err != nil {
+		return nil, err
+	}
+
+	var (
+		answer    string
+		ragAnswer *ragv1.RagAnswer
+	)
+	if len(stage.similarChunks) == 0 {
+		answer = noResultsMessage(stage.query)
+	} else {
+		s.runRerankStage(ctx, stage)
+
+		answer, ragAnswer, err = s.generateContextSummary(ctx, stage.rankedChunks, stage.query, stage.systemPrompt(), "", historyMessages(history))
+		if err != nil {
+			logger.Get().Error("对话回答生成失败，回退到模板回答", slog.Any("error", err))
+			answer = s.buildContextResponse(stage.rankedChunks, stage.query)
+		}
+	}
+
+	session.append(chatTurn{Role: "user", Content: message}, chatTurn{Role: "assistant", Content: answer})
+	if err := s.Cache.SetSession(ctx, sessionID, session, redis.SessionTTL); err != nil {
+		logger.Get().Warn("保存会话失败", slog.String("session_id", sessionID), slog.Any("error", err))
+	}
+
+	logger.Get().Info("对话请求完成",
+		slog.String("session_id", sessionID),
+		slog.String("standalone_query", standaloneQuery),
+		slog.Int("chunks_used", len(stage.rankedChunks)),
+		slog.Int("answer_length", len(answer)),
+		slog.Duration("total_duration", time.Since(startTime)),
+	)
+
+	return connect.NewResponse(&ragv1.ChatResponse{
+		SessionId:       sessionID,
+		Answer:          answer,
+		StandaloneQuery: standaloneQuery,
+		Keywords:        stage.keywords,
+		Chunks:          toRetrievedChunks(stage.rankedChunks),
+		RagAnswer:       ragAnswer,
+	}), nil
+}
+
+// recentTurns returns the tail of the session that is replayed into prompts.
+func (cs *chatSession) recentTurns() []chatTurn {
+	if len(cs.Turns) > promptHistoryTurns {
+		return cs.Turns[len(cs.Turns)-promptHistoryTurns:]
+	}
+	return cs.Turns
+}
+
+func (cs *chatSession) append(turns ...chatTurn) {
+	cs.Turns = append(cs.Turns, turns...)
+	if len(cs.Turns) > maxSessionTurns {
+		cs.Turns = cs.Turns[len(cs.Turns)-maxSessionTurns:]
+	}
+	cs.UpdatedAt = time.Now()
+}
+
+func historyMessages(turns []chatTurn) []openai.Message {
+	messages := make([]openai.Message, 0, len(turns))
+	for _, turn := range turns {
+		messages = append(messages, openai.Message{
+			Role:    turn.Role,
+			Content: pkgutils.SafeUTF8Truncate(turn.Content, historyTurnMaxBytes),
+		})
+	}
+	return messages
+}
+
+// rewriteQuery turns a follow-up question into a standalone retrieval query.
+//
+// The original message is returned unchanged when there is no history or
+// the LLM is unavailable, so retrieval never blocks on the rewrite.
+func (s *RagServer) rewriteQuery(ctx context.Context, history []chatTurn, message string) string {
+	if len(history) == 0 {
+		return message
+	}
+	if s.LLM == nil || s.Config == nil {
+		logger.Get().Warn("LLM service not initialized, skipping query rewrite")
+		return message
+	}
+
+	var historyText strings.Builder
+	for _, turn := range history {
+		role := "用户"
+		if turn.Role == "assistant" {
+			role = "助手"
+		}
+		historyText.WriteString(fmt.Sprintf("%s：%s\n", role, pkgutils.SafeUTF8Truncate(turn.Content, historyTurnMaxBytes)))
+	}
+
+	pm := s.promptManager()
+	prompt, err := pm.GetPrompt(prompts.PromptTypeQueryRewrite)
+	if err != nil {
+		logger.Get().Error("获取查询改写提示词失败", slog.Any("error", err))
+		return message
+	}
+	userContent, err := pm.RenderUserPrompt(prompts.PromptTypeQueryRewrite, map[string]string{
+		"history": historyText.String(),
+		"query":   message,
+	})
+	if err != nil {
+		logger.Get().Error("渲染查询改写提示词失败", slog.Any("error", err))
+		return message
+	}
+
+	resp, err := s.completePrompt(ctx, openai.TaskQueryRewrite, "", prompt, userContent)
+	if err != nil {
+		logger.Get().Error("LLM查询改写失败，使用原始问题", slog.Any("error", err))
+		return message
+	}
+	if len(resp.Choices) == 0 {
+		return message
+	}
+
+	var rewritten string
+	if resp.Structured {
+		output, err := openai.DecodeJSON[prompts.QueryRewriteOutput](resp.Choices[0].Message.Content)
+		if err != nil {
+			logger.Get().Warn("结构化查询改写解析失败，使用原始问题", slog.Any("error", err))
+			return message
+		}
+		rewritten = output.Query
+	} else {
+		rewritten = parseRewrittenQuery(resp.Choices[0].Message.Content)
+	}
+	if rewritten == "" {
+		return message
+	}
+	logger.Get().Debug("查询改写完成",
+		slog.String("original", message),
+		slog.String("rewritten", rewritten),
+	)
+	return rewritten
+}
+
+// parseRewrittenQuery extracts the query from a <query> element, accepting
+// a bare answer when the model ignores the tag.
+func parseRewrittenQuery(content string) string {
+	content = strings.TrimSpace(content)
+	if start := strings.Index(content, "<query>"); start >= 0 {
+		content = content[start+len("<query>"):]
+		if end := strings.Index(content, "</query>"); end >= 0 {
+			content = content[:end]
+		}
+	}
+	content = strings.Trim(strings.TrimSpace(content), `"“”`)
+	if strings.Contains(content, "<") || len(content) > 2000 {
+		return ""
+	}
+	return content
+}
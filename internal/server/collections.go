@@ -0,0 +1,199 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hsn0918/rag/internal/adapters"
+	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
+	pkgembedding "github.com/hsn0918/rag/pkg/clients/embedding"
+	"github.com/hsn0918/rag/pkg/config"
+	"github.com/hsn0918/rag/pkg/logger"
+)
+
+// CreateCollection 创建集合。配置覆盖会先与服务端配置合并校验，
+// 指定向量模型时还会试算一次向量，确认其维度与向量表一致。
+func (s *RagServer) CreateCollection(
+	ctx context.Context,
+	req *connect.Request[ragv1.CreateCollectionRequest],
+) (*connect.Response[ragv1.CreateCollectionResponse], error) {
+	name := strings.TrimSpace(req.Msg.GetName())
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+
+	settings := collectionSettingsFromProto(req.Msg.GetSettings())
+	chunkConfig := s.Config.Chunking
+	applyChunkingOverrides(&chunkConfig, settings)
+	if err := chunkConfig.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid chunking settings: %w", err))
+	}
+	if settings.EmbeddingModel != "" {
+		vec, err := s.generateEmbedding(ctx, settings.EmbeddingModel, s.embeddingDimensions(settings.EmbeddingModel), name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("embedding model %q is unusable: %w", settings.EmbeddingModel, err))
+		}
+		if dims := s.DB.GetDimensions(); len(vec) != dims {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("embedding model %q produces %d dimensions, expected %d", settings.EmbeddingModel, len(vec), dims))
+		}
+	}
+
+	collection, err := s.DB.CreateCollection(ctx, name, req.Msg.GetDescription(), settings)
+	if errors.Is(err, adapters.ErrCollectionExists) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	logger.Get().Info("集合已创建", "collection_id", collection.ID, "name", collection.Name)
+
+	return connect.NewResponse(&ragv1.CreateCollectionResponse{
+		Collection: toProtoCollection(collection),
+	}), nil
+}
+
+// ListCollections 按名称列出全部集合
+func (s *RagServer) ListCollections(
+	ctx context.Context,
+	_ *connect.Request[ragv1.ListCollectionsRequest],
+) (*connect.Response[ragv1.ListCollectionsResponse], error) {
+	collections, err := s.DB.ListCollections(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	out := make([]*ragv1.Collection, 0, len(collections))
+	for i := range collections {
+		out = append(out, toProtoCollection(&collections[i]))
+	}
+	return connect.NewResponse(&ragv1.ListCollectionsResponse{Collections: out}), nil
+}
+
+// DeleteCollection 删除集合及其全部文档和分块
+func (s *RagServer) DeleteCollection(
+	ctx context.Context,
+	req *connect.Request[ragv1.DeleteCollectionRequest],
+) (*connect.Response[ragv1.DeleteCollectionResponse], error) {
+	if err := s.DB.DeleteCollection(ctx, req.Msg.GetCollectionId()); err != nil {
+		if errors.Is(err, adapters.ErrCollectionNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	logger.Get().Info("集合已删除", "collection_id", req.Msg.GetCollectionId())
+
+	return connect.NewResponse(&ragv1.DeleteCollectionResponse{
+		Success: true,
+		Message: "collection deleted",
+	}), nil
+}
+
+// lookupCollection loads the collection a request refers to. An empty ID
+// yields nil, meaning no collection; an unknown ID is NotFound.
+func (s *RagServer) lookupCollection(ctx context.Context, id string) (*adapters.Collection, error) {
+	if id == "" {
+		return nil, nil
+	}
+	collection, err := s.DB.GetCollection(ctx, id)
+	if errors.Is(err, adapters.ErrCollectionNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%w: %s", err, id))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return collection, nil
+}
+
+// queryScope resolves a query's collection and filter. The collection, when
+// given, is added to the filter so retrieval stays inside it. Without one,
+// the query spans every collection, so the filter keeps it to documents
+// embedded with the query's model: collections that override the model
+// produce vectors a cosine with the query vector is meaningless for.
+func (s *RagServer) queryScope(ctx context.Context, collectionID string, f *ragv1.SearchFilter) (adapters.SearchFilter, *adapters.Collection, error) {
+	filter, err := searchFilterFromProto(f)
+	if err != nil {
+		return adapters.SearchFilter{}, nil, err
+	}
+	collection, err := s.lookupCollection(ctx, collectionID)
+	if err != nil {
+		return adapters.SearchFilter{}, nil, err
+	}
+	if collection != nil {
+		filter.CollectionID = collection.ID
+	} else {
+		filter.EmbeddingModel = s.embeddingModel(nil)
+	}
+	return filter, collection, nil
+}
+
+// embeddingModel returns the embedding model for documents and queries in
+// collection, which may be nil. Without an override it is the model that
+// produced the active table set, which differs from the configured model
+// until a re-index migrates the documents.
+func (s *RagServer) embeddingModel(collection *adapters.Collection) string {
+	if collection != nil && collection.Settings.EmbeddingModel != "" {
+		return collection.Settings.EmbeddingModel
+	}
+	return s.DB.ActiveTableSet().Model
+}
+
+// embeddingDimensions returns the output size to request from model so its
+// vectors fit the active table set: the set's size when model can shorten
+// its output to it, zero (the native size) otherwise.
+func (s *RagServer) embeddingDimensions(model string) int {
+	return pkgembedding.RequestDimensions(model, s.DB.GetDimensions())
+}
+
+// chunkingConfig returns the server chunking config with collection's
+// overrides applied.
+func (s *RagServer) chunkingConfig(collection *adapters.Collection) config.ChunkingConfig {
+	chunkConfig := s.Config.Chunking
+	if collection != nil {
+		applyChunkingOverrides(&chunkConfig, collection.Settings)
+	}
+	return chunkConfig
+}
+
+func applyChunkingOverrides(chunkConfig *config.ChunkingConfig, settings adapters.CollectionSettings) {
+	if settings.MaxChunkSize > 0 {
+		chunkConfig.MaxChunkSize = settings.MaxChunkSize
+	}
+	if settings.MinChunkSize > 0 {
+		chunkConfig.MinChunkSize = settings.MinChunkSize
+	}
+	if settings.OverlapSize > 0 {
+		chunkConfig.OverlapSize = settings.OverlapSize
+	}
+}
+
+func collectionSettingsFromProto(settings *ragv1.CollectionSettings) adapters.CollectionSettings {
+	return adapters.CollectionSettings{
+		MaxChunkSize:   int(settings.GetMaxChunkSize()),
+		MinChunkSize:   int(settings.GetMinChunkSize()),
+		OverlapSize:    int(settings.GetOverlapSize()),
+		EmbeddingModel: strings.TrimSpace(settings.GetEmbeddingModel()),
+		SystemPrompt:   strings.TrimSpace(settings.GetSystemPrompt()),
+	}
+}
+
+func toProtoCollection(collection *adapters.Collection) *ragv1.Collection {
+	return &ragv1.Collection{
+		Id:          collection.ID,
+		Name:        collection.Name,
+		Description: collection.Description,
+		Settings: &ragv1.CollectionSettings{
+			MaxChunkSize:   int32(collection.Settings.MaxChunkSize),
+			MinChunkSize:   int32(collection.Settings.MinChunkSize),
+			OverlapSize:    int32(collection.Settings.OverlapSize),
+			EmbeddingModel: collection.Settings.EmbeddingModel,
+			SystemPrompt:   collection.Settings.SystemPrompt,
+		},
+		CreatedAt:     collection.CreatedAt.UTC().Format(time.RFC3339),
+		DocumentCount: collection.DocumentCount,
+	}
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"log/slog"
+
+	"github.com/hsn0918/rag/internal/adapters"
+	"github.com/hsn0918/rag/pkg/config"
+	"github.com/hsn0918/rag/pkg/contextpack"
+	"github.com/hsn0918/rag/pkg/logger"
+	pkgutils "github.com/hsn0918/rag/pkg/utils"
+)
+
+// contextPassageOverhead approximates the tokens of the header, type and
+// source lines rendered around each passage of the summary prompt.
+const contextPassageOverhead = 40
+
+// packContext fits chunks into the configured context token budget of the
+// summary prompt. Chunks arrive ranked, so their rank is the packing score.
+// Chunks left out are marked with Metadata["context_dropped"] so responses
+// can report them.
+func (s *RagServer) packContext(chunks []adapters.ChunkSearchResult) contextpack.Result {
+	budget := config.DefaultContextTokens
+	if s.Config != nil && s.Config.Search.ContextTokens > 0 {
+		budget = s.Config.Search.ContextTokens
+	}
+
+	items := make([]contextpack.Chunk, len(chunks))
+	for i, chunk := range chunks {
+		items[i] = contextpack.Chunk{
+			DocumentID: chunk.DocumentID,
+			Index:      chunk.ChunkIndex,
+			// The packer trims to the budget, so only clean the content here.
+			Content: pkgutils.CleanAndFormatContent(chunk.Content, len(chunk.Content)),
+			Score:   float64(len(chunks) - i),
+		}
+	}
+	result := contextpack.New(budget, contextpack.WithPassageOverhead(contextPassageOverhead)).Pack(items)
+
+	if len(result.Dropped) > 0 {
+		dropped := make([]string, 0, len(result.Dropped))
+		for _, pos := range result.Dropped {
+			chunk := &chunks[pos]
+			if chunk.Metadata == nil {
+				chunk.Metadata = make(map[string]interface{})
+			}
+			chunk.Metadata["context_dropped"] = true
+			dropped = append(dropped, chunk.ChunkID)
+		}
+		logger.Get().Info("部分分块超出上下文 token 预算，未放入总结提示词",
+			slog.Int("budget", budget),
+			slog.Int("used_tokens", result.Tokens),
+			slog.Any("dropped_chunks", dropped),
+		)
+	}
+	return result
+}
@@ -20,7 +20,7 @@ func (s *RagServer) DeleteDocument(
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("document_id is required"))
 	}
 
-	if err := s.DB.DeleteDocument(ctx, docID); err != nil {
+	if err := s.DB.DeleteDocument(ctx, req.Msg.GetCollectionId(), docID); err != nil {
 		if errors.Is(err, adapters.ErrDocumentNotFound) {
 			return nil, connect.NewError(connect.CodeNotFound, err)
 		}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hsn0918/rag/internal/adapters"
+	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
+)
+
+var metadataOperators = map[ragv1.MetadataOperator]adapters.MetadataOp{
+	ragv1.MetadataOperator_METADATA_OPERATOR_EQ:       adapters.MetadataOpEq,
+	ragv1.MetadataOperator_METADATA_OPERATOR_NE:       adapters.MetadataOpNe,
+	ragv1.MetadataOperator_METADATA_OPERATOR_IN:       adapters.MetadataOpIn,
+	ragv1.MetadataOperator_METADATA_OPERATOR_CONTAINS: adapters.MetadataOpContains,
+	ragv1.MetadataOperator_METADATA_OPERATOR_EXISTS:   adapters.MetadataOpExists,
+	ragv1.MetadataOperator_METADATA_OPERATOR_GT:       adapters.MetadataOpGt,
+	ragv1.MetadataOperator_METADATA_OPERATOR_GTE:      adapters.MetadataOpGte,
+	ragv1.MetadataOperator_METADATA_OPERATOR_LT:       adapters.MetadataOpLt,
+	ragv1.MetadataOperator_METADATA_OPERATOR_LTE:      adapters.MetadataOpLte,
+}
+
+// searchFilterFromProto converts a request filter into its database form.
+// A nil filter yields the zero value, which searches every document.
+// Invalid filters are reported as InvalidArgument.
+func searchFilterFromProto(f *ragv1.SearchFilter) (adapters.SearchFilter, error) {
+	if f == nil {
+		return adapters.SearchFilter{}, nil
+	}
+
+	filter := adapters.SearchFilter{
+		DocumentIDs: f.GetDocumentIds(),
+		Tags:        f.GetTags(),
+		Uploader:    f.GetUploader(),
+	}
+
+	var err error
+	if filter.CreatedAfter, err = parseFilterTime("created_after", f.GetCreatedAfter()); err != nil {
+		return adapters.SearchFilter{}, err
+	}
+	if filter.CreatedBefore, err = parseFilterTime("created_before", f.GetCreatedBefore()); err != nil {
+		return adapters.SearchFilter{}, err
+	}
+
+	for _, cond := range f.GetMetadata() {
+		op, ok := metadataOperators[cond.GetOperator()]
+		if !ok {
+			return adapters.SearchFilter{}, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("unsupported metadata operator %s", cond.GetOperator()))
+		}
+		scope := adapters.MetadataScopeDocument
+		if cond.GetScope() == ragv1.MetadataScope_METADATA_SCOPE_CHUNK {
+			scope = adapters.MetadataScopeChunk
+		}
+		values := make([]json.RawMessage, len(cond.GetValuesJson()))
+		for i, v := range cond.GetValuesJson() {
+			values[i] = json.RawMessage(v)
+		}
+		filter.Metadata = append(filter.Metadata, adapters.MetadataCondition{
+			Scope:  scope,
+			Path:   cond.GetPath(),
+			Op:     op,
+			Values: values,
+		})
+	}
+
+	if err := filter.Validate(); err != nil {
+		return adapters.SearchFilter{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return filter, nil
+}
+
+// annParamsFromProto converts request ANN options; nil means the server
+// defaults.
+func annParamsFromProto(o *ragv1.AnnOptions) adapters.ANNParams {
+	return adapters.ANNParams{
+		EfSearch: int(o.GetEfSearch()),
+		Probes:   int(o.GetProbes()),
+	}
+}
+
+func parseFilterTime(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("filter.%s must be an RFC3339 timestamp: %w", field, err))
+	}
+	return t, nil
+}
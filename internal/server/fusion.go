@@ -0,0 +1,225 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hsn0918/rag/internal/adapters"
+)
+
+// Fusion strategy names accepted by NewFusionStrategy and the
+// search.fusion config key.
+const (
+	FusionRRF      = "rrf"
+	FusionWeighted = "weighted"
+	FusionAverage  = "average"
+)
+
+// DefaultRRFK is the rank offset from the original RRF paper. Larger values
+// flatten the advantage of top-ranked results.
+const DefaultRRFK = 60
+
+// FusionStrategy merges the vector and keyword result lists into one,
+// deduplicated by chunk ID and ordered by fused score.
+//
+// The fused score, in [0,1], replaces Similarity so later stages compare
+// like with like. It is a rank-derived score, not a cosine, so similarity
+// thresholds must use vector_similarity instead. The inputs are recorded in Metadata for debugging:
+// vector_rank and keyword_rank (1-based, absent when the chunk was not in
+// that list), vector_similarity, text_rank, fusion_score and fusion.
+type FusionStrategy interface {
+	Name() string
+	Fuse(vectorResults, keywordResults []adapters.ChunkSearchResult) []adapters.ChunkSearchResult
+}
+
+// NewFusionStrategy returns the strategy with the given name. k applies to
+// RRF and the weights to weighted fusion; zero values take the defaults.
+func NewFusionStrategy(name string, k, vectorWeight, keywordWeight float64) (FusionStrategy, error) {
+	switch name {
+	case FusionRRF, "":
+		if k <= 0 {
+			k = DefaultRRFK
+		}
+		return RRFFusion{K: k}, nil
+	case FusionWeighted:
+		if vectorWeight == 0 && keywordWeight == 0 {
+			vectorWeight, keywordWeight = 0.7, 0.3
+		}
+		if vectorWeight < 0 || keywordWeight < 0 {
+			return nil, fmt.Errorf("%w: fusion weights must not be negative", ErrInvalidOptConfig)
+		}
+		return WeightedFusion{VectorWeight: vectorWeight, KeywordWeight: keywordWeight}, nil
+	case FusionAverage:
+		return AverageFusion{}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown fusion strategy %q", ErrInvalidOptConfig, name)
+	}
+}
+
+// RRFFusion is Reciprocal Rank Fusion: each list contributes 1/(K+rank),
+// so only positions matter and the incomparable cosine and ts_rank scales
+// never meet. The sum is divided by its maximum, 2/(K+1).
+type RRFFusion struct {
+	K float64
+}
+
+// Name implements FusionStrategy.
+func (RRFFusion) Name() string { return FusionRRF }
+
+// Fuse implements FusionStrategy.
+func (f RRFFusion) Fuse(vectorResults, keywordResults []adapters.ChunkSearchResult) []adapters.ChunkSearchResult {
+	return fuseResults(f.Name(), vectorResults, keywordResults, func(e *fusionEntry) float64 {
+		score := 0.0
+		if e.vectorRank > 0 {
+			score += 1 / (f.K + float64(e.vectorRank))
+		}
+		if e.keywordRank > 0 {
+			score += 1 / (f.K + float64(e.keywordRank))
+		}
+		return score / (2 / (f.K + 1))
+	})
+}
+
+// WeightedFusion min-max normalizes each list's scores to [0,1] and takes
+// their weighted sum, divided by the total weight. A chunk missing from a
+// list scores zero for it.
+type WeightedFusion struct {
+	VectorWeight  float64
+	KeywordWeight float64
+}
+
+// Name implements FusionStrategy.
+func (WeightedFusion) Name() string { return FusionWeighted }
+
+// Fuse implements FusionStrategy.
+func (f WeightedFusion) Fuse(vectorResults, keywordResults []adapters.ChunkSearchResult) []adapters.ChunkSearchResult {
+	vectorNorm := minMaxNormalizer(vectorResults)
+	keywordNorm := minMaxNormalizer(keywordResults)
+	total := f.VectorWeight + f.KeywordWeight
+	return fuseResults(f.Name(), vectorResults, keywordResults, func(e *fusionEntry) float64 {
+		if total == 0 {
+			return 0
+		}
+		score := 0.0
+		if e.vectorRank > 0 {
+			score += f.VectorWeight * vectorNorm(e.vectorScore)
+		}
+		if e.keywordRank > 0 {
+			score += f.KeywordWeight * keywordNorm(e.keywordScore)
+		}
+		return score / total
+	})
+}
+
+// AverageFusion averages the raw scores of chunks found by both searches
+// and keeps the single score otherwise. It mixes cosine similarity with
+// ts_rank and is kept for comparison with the older behaviour.
+type AverageFusion struct{}
+
+// Name implements FusionStrategy.
+func (AverageFusion) Name() string { return FusionAverage }
+
+// Fuse implements FusionStrategy.
+func (f AverageFusion) Fuse(vectorResults, keywordResults []adapters.ChunkSearchResult) []adapters.ChunkSearchResult {
+	return fuseResults(f.Name(), vectorResults, keywordResults, func(e *fusionEntry) float64 {
+		switch {
+		case e.vectorRank > 0 && e.keywordRank > 0:
+			return (e.vectorScore + e.keywordScore) / 2
+		case e.vectorRank > 0:
+			return e.vectorScore
+		default:
+			return e.keywordScore
+		}
+	})
+}
+
+type fusionEntry struct {
+	result       adapters.ChunkSearchResult
+	vectorRank   int
+	keywordRank  int
+	vectorScore  float64
+	keywordScore float64
+}
+
+// fuseResults collects per-source ranks and scores by chunk ID, scores each
+// chunk with score and returns the chunks by descending fused score.
+func fuseResults(
+	name string,
+	vectorResults, keywordResults []adapters.ChunkSearchResult,
+	score func(*fusionEntry) float64,
+) []adapters.ChunkSearchResult {
+	entries := make(map[string]*fusionEntry, len(vectorResults)+len(keywordResults))
+	var order []string
+
+	add := func(results []adapters.ChunkSearchResult, keyword bool) {
+		for i, result := range results {
+			entry, ok := entries[result.ChunkID]
+			if !ok {
+				entry = &fusionEntry{result: result}
+				entries[result.ChunkID] = entry
+				order = append(order, result.ChunkID)
+			}
+			// Keep the first occurrence when a list repeats a chunk.
+			if keyword && entry.keywordRank == 0 {
+				entry.keywordRank = i + 1
+				entry.keywordScore = float64(result.Similarity)
+			} else if !keyword && entry.vectorRank == 0 {
+				entry.vectorRank = i + 1
+				entry.vectorScore = float64(result.Similarity)
+			}
+		}
+	}
+	add(vectorResults, false)
+	add(keywordResults, true)
+
+	fused := make([]adapters.ChunkSearchResult, 0, len(order))
+	for _, id := range order {
+		entry := entries[id]
+		result := entry.result
+
+		metadata := make(map[string]interface{}, len(result.Metadata)+6)
+		for k, v := range result.Metadata {
+			metadata[k] = v
+		}
+		if entry.vectorRank > 0 {
+			metadata["vector_rank"] = entry.vectorRank
+			metadata["vector_similarity"] = entry.vectorScore
+		}
+		if entry.keywordRank > 0 {
+			metadata["keyword_rank"] = entry.keywordRank
+			metadata["text_rank"] = entry.keywordScore
+		}
+
+		fusedScore := score(entry)
+		metadata["fusion_score"] = fusedScore
+		metadata["fusion"] = name
+
+		result.Metadata = metadata
+		result.Similarity = float32(fusedScore)
+		fused = append(fused, result)
+	}
+
+	sort.SliceStable(fused, func(i, j int) bool {
+		return fused[i].Similarity > fused[j].Similarity
+	})
+	return fused
+}
+
+// minMaxNormalizer maps scores from results onto [0,1]. When all scores
+// are equal every score maps to 1.
+func minMaxNormalizer(results []adapters.ChunkSearchResult) func(float64) float64 {
+	if len(results) == 0 {
+		return func(float64) float64 { return 0 }
+	}
+	lo, hi := float64(results[0].Similarity), float64(results[0].Similarity)
+	for _, result := range results[1:] {
+		lo = min(lo, float64(result.Similarity))
+		hi = max(hi, float64(result.Similarity))
+	}
+	return func(score float64) float64 {
+		if hi == lo {
+			return 1
+		}
+		return (score - lo) / (hi - lo)
+	}
+}
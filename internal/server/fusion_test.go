@@ -0,0 +1,229 @@
+package server
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/hsn0918/rag/internal/adapters"
+)
+
+func chunkResults(ids []string, scores ...float32) []adapters.ChunkSearchResult {
+	out := make([]adapters.ChunkSearchResult, len(ids))
+	for i, id := range ids {
+		out[i] = adapters.ChunkSearchResult{ChunkID: id, Similarity: scores[i]}
+	}
+	return out
+}
+
+func fusedScores(fused []adapters.ChunkSearchResult) map[string]float64 {
+	scores := make(map[string]float64, len(fused))
+	for _, r := range fused {
+		scores[r.ChunkID] = r.Metadata["fusion_score"].(float64)
+	}
+	return scores
+}
+
+func approxEqual(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+
+func TestRRFFusion(t *testing.T) {
+	const k float64 = DefaultRRFK
+	tests := []struct {
+		name    string
+		vector  []adapters.ChunkSearchResult
+		keyword []adapters.ChunkSearchResult
+		order   []string
+		scores  map[string]float64
+	}{
+		{
+			name:   "empty",
+			order:  []string{},
+			scores: map[string]float64{},
+		},
+		{
+			name:    "first in both lists scores one",
+			vector:  chunkResults([]string{"a", "b"}, 0.9, 0.8),
+			keyword: chunkResults([]string{"a"}, 0.1),
+			order:   []string{"a", "b"},
+			scores: map[string]float64{
+				"a": 1,
+				"b": (1 / (k + 2)) / (2 / (k + 1)),
+			},
+		},
+		{
+			name:    "first in one list scores one half",
+			vector:  chunkResults([]string{"a"}, 0.9),
+			keyword: chunkResults([]string{"b"}, 0.3),
+			order:   []string{"a", "b"},
+			scores:  map[string]float64{"a": 0.5, "b": 0.5},
+		},
+		{
+			name:    "ranks not raw scores decide",
+			vector:  chunkResults([]string{"a", "b", "c"}, 0.99, 0.98, 0.2),
+			keyword: chunkResults([]string{"c", "b"}, 5, 0.01),
+			order:   []string{"c", "b", "a"},
+			scores: map[string]float64{
+				"a": (1 / (k + 1)) / (2 / (k + 1)),
+				"b": (1/(k+2) + 1/(k+2)) / (2 / (k + 1)),
+				"c": (1/(k+3) + 1/(k+1)) / (2 / (k + 1)),
+			},
+		},
+		{
+			name:    "repeated chunk keeps first rank",
+			vector:  chunkResults([]string{"a", "b", "a"}, 0.9, 0.8, 0.7),
+			keyword: nil,
+			order:   []string{"a", "b"},
+			scores: map[string]float64{
+				"a": 0.5,
+				"b": (1 / (k + 2)) / (2 / (k + 1)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fused := RRFFusion{K: k}.Fuse(tt.vector, tt.keyword)
+			if len(fused) != len(tt.order) {
+				t.Fatalf("got %d results, want %d", len(fused), len(tt.order))
+			}
+			for i, id := range tt.order {
+				if fused[i].ChunkID != id {
+					t.Errorf("result %d = %s, want %s", i, fused[i].ChunkID, id)
+				}
+			}
+			for id, got := range fusedScores(fused) {
+				if want := tt.scores[id]; !approxEqual(got, want) {
+					t.Errorf("score of %s = %v, want %v", id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFuseResultsMetadata(t *testing.T) {
+	vector := chunkResults([]string{"a"}, 0.83)
+	vector[0].Metadata = map[string]interface{}{"chunk_type": "section"}
+	keyword := chunkResults([]string{"a", "b"}, 0.4, 0.2)
+
+	fused := RRFFusion{K: DefaultRRFK}.Fuse(vector, keyword)
+	a, b := fused[0], fused[1]
+
+	if a.Similarity != 1 {
+		t.Errorf("Similarity = %v, want the fused score 1", a.Similarity)
+	}
+	if got := a.Metadata["vector_similarity"].(float64); !approxEqual(got, 0.83) {
+		t.Errorf("vector_similarity = %v, want 0.83", got)
+	}
+	if a.Metadata["vector_rank"] != 1 || a.Metadata["keyword_rank"] != 1 {
+		t.Errorf("ranks = %v/%v, want 1/1", a.Metadata["vector_rank"], a.Metadata["keyword_rank"])
+	}
+	if a.Metadata["chunk_type"] != "section" || a.Metadata["fusion"] != FusionRRF {
+		t.Errorf("metadata = %v, want chunk metadata kept and fusion recorded", a.Metadata)
+	}
+	if vector[0].Metadata["fusion"] != nil {
+		t.Error("input metadata was modified")
+	}
+	if _, ok := b.Metadata["vector_similarity"]; ok {
+		t.Error("keyword-only chunk has vector_similarity")
+	}
+}
+
+func TestWeightedFusion(t *testing.T) {
+	tests := []struct {
+		name          string
+		vectorWeight  float64
+		keywordWeight float64
+		vector        []adapters.ChunkSearchResult
+		keyword       []adapters.ChunkSearchResult
+		scores        map[string]float64
+	}{
+		{
+			name:         "min-max normalized per list",
+			vectorWeight: 1,
+			vector:       chunkResults([]string{"a", "b", "c"}, 0.9, 0.7, 0.5),
+			scores:       map[string]float64{"a": 1, "b": 0.5, "c": 0},
+		},
+		{
+			name:          "equal scores normalize to one",
+			vectorWeight:  1,
+			keywordWeight: 1,
+			vector:        chunkResults([]string{"a", "b"}, 0.6, 0.6),
+			keyword:       chunkResults([]string{"a"}, 0.02),
+			scores:        map[string]float64{"a": 1, "b": 0.5},
+		},
+		{
+			name:          "weights divided by their total",
+			vectorWeight:  0.7,
+			keywordWeight: 0.3,
+			vector:        chunkResults([]string{"a", "b"}, 0.9, 0.5),
+			keyword:       chunkResults([]string{"b", "a"}, 0.8, 0.1),
+			scores:        map[string]float64{"a": 0.7, "b": 0.3},
+		},
+		{
+			name:          "missing from a list scores zero for it",
+			vectorWeight:  3,
+			keywordWeight: 1,
+			vector:        chunkResults([]string{"a", "b"}, 0.9, 0.5),
+			keyword:       chunkResults([]string{"c", "d"}, 0.8, 0.4),
+			scores:        map[string]float64{"a": 0.75, "b": 0, "c": 0.25, "d": 0},
+		},
+		{
+			name:   "zero weights score zero",
+			vector: chunkResults([]string{"a"}, 0.9),
+			scores: map[string]float64{"a": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fusion := WeightedFusion{VectorWeight: tt.vectorWeight, KeywordWeight: tt.keywordWeight}
+			got := fusedScores(fusion.Fuse(tt.vector, tt.keyword))
+			if len(got) != len(tt.scores) {
+				t.Fatalf("got %d results, want %d", len(got), len(tt.scores))
+			}
+			for id, want := range tt.scores {
+				if !approxEqual(got[id], want) {
+					t.Errorf("score of %s = %v, want %v", id, got[id], want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewFusionStrategy(t *testing.T) {
+	tests := []struct {
+		name          string
+		strategy      string
+		k             float64
+		vectorWeight  float64
+		keywordWeight float64
+		want          FusionStrategy
+		wantErr       bool
+	}{
+		{name: "default is rrf", want: RRFFusion{K: DefaultRRFK}},
+		{name: "rrf k", strategy: FusionRRF, k: 10, want: RRFFusion{K: 10}},
+		{name: "weighted defaults", strategy: FusionWeighted, want: WeightedFusion{VectorWeight: 0.7, KeywordWeight: 0.3}},
+		{name: "weighted", strategy: FusionWeighted, vectorWeight: 1, want: WeightedFusion{VectorWeight: 1}},
+		{name: "negative weight", strategy: FusionWeighted, vectorWeight: 1, keywordWeight: -1, wantErr: true},
+		{name: "average", strategy: FusionAverage, want: AverageFusion{}},
+		{name: "unknown", strategy: "max", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFusionStrategy(tt.strategy, tt.k, tt.vectorWeight, tt.keywordWeight)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidOptConfig) {
+					t.Fatalf("err = %v, want ErrInvalidOptConfig", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
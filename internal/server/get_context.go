@@ -19,8 +19,33 @@ import (
 	pkgutils "github.com/hsn0918/rag/pkg/utils"
 )
 
+// Defaults for the number of chunks kept after reranking and the minimum
+// similarity they must reach.
+const (
+	defaultRerankTopK          = 5
+	defaultRerankMinSimilarity = 0.25
+)
+
+// contextStages carries the state shared by the retrieval stages. The
+// request-tunable fields use their zero value to mean "use the default".
 type contextStages struct {
 	query         string
+	topK          int
+	minSimilarity float32
+	// basicKeywords skips LLM keyword extraction in favour of local
+	// segmentation.
+	basicKeywords bool
+	// filter restricts retrieval; the zero value searches every document.
+	filter adapters.SearchFilter
+	// ann overrides the vector index query parameters.
+	ann adapters.ANNParams
+	// model replaces the LLM providers' default models; it has been checked
+	// against the allowlist.
+	model string
+	// collection is the collection being queried, nil for all documents.
+	// Its embedding model and system prompt override the server defaults.
+	collection *adapters.Collection
+
 	keywords      []string
 	queryText     string
 	queryVector   []float32
@@ -57,7 +82,20 @@ func (s *RagServer) GetContext(
 		slog.Time("start_time", startTime),
 	)
 
-	stage := &contextStages{query: query}
+	if err := s.checkModel(req.Msg.GetModel()); err != nil {
+		return nil, err
+	}
+	filter, collection, err := s.queryScope(ctx, req.Msg.GetCollectionId(), req.Msg.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	stage := &contextStages{
+		query:      query,
+		filter:     filter,
+		ann:        annParamsFromProto(req.Msg.GetAnn()),
+		model:      req.Msg.GetModel(),
+		collection: collection,
+	}
 
 	if err := s.runKeywordStage(ctx, stage); err != nil {
 		return nil, err
@@ -75,42 +113,20 @@ func (s *RagServer) GetContext(
 			slog.Duration("total_duration", time.Since(startTime)),
 		)
 		return connect.NewResponse(&ragv1.GetContextResponse{
-			Context: fmt.Sprintf("未找到与查询 '%s' 相关的内容。请尝试使用不同的关键词。", stage.query),
+			Context: noResultsMessage(stage.query),
 		}), nil
 	}
 
 	// 第四步：智能重排序 - 综合向量相似度和关键词匹配
-	logger.Get().Debug("开始智能重排序",
-		slog.Int("chunks_before", len(stage.similarChunks)),
-	)
-	rerankStart := time.Now()
-	stage.rankedChunks = s.rerankChunksWithKeywords(stage.similarChunks, stage.query, stage.keywords)
-	rerankDuration := time.Since(rerankStart)
-
-	logger.Get().Info("重排序完成",
-		slog.Int("chunks_after", len(stage.rankedChunks)),
-		slog.Duration("rerank_duration", rerankDuration),
-	)
+	s.runRerankStage(ctx, stage)
 
-	if logger.Get().Enabled(ctx, slog.LevelDebug) && len(stage.rankedChunks) > 0 {
-		for i, chunk := range stage.rankedChunks {
-			if i < 3 { // Log top 3 reranked results
-				logger.Get().Debug("重排序结果",
-					slog.Int("final_rank", i+1),
-					slog.String("chunk_id", chunk.ChunkID),
-					slog.Float64("similarity", float64(chunk.Similarity)),
-					slog.Any("advanced_score", chunk.Metadata["advanced_score"]),
-				)
-			}
-		}
-	}
 	// 第五步：使用大模型生成个性化总结回答
 	logger.Get().Debug("开始生成个性化回答",
 		slog.String("query", stage.query),
 		slog.Int("chunks_count", len(stage.rankedChunks)),
 	)
 	summaryStart := time.Now()
-	contextContent, err := s.generateContextSummary(ctx, stage.rankedChunks, stage.query)
+	contextContent, answer, err := s.generateContextSummary(ctx, stage.rankedChunks, stage.query, stage.systemPrompt(), stage.model, nil)
 	summaryDuration := time.Since(summaryStart)
 
 	if err != nil {
@@ -141,15 +157,194 @@ func (s *RagServer) GetContext(
 	)
 
 	return connect.NewResponse(&ragv1.GetContextResponse{
-		Context:  contextContent,
-		Keywords: stage.keywords,
+		Context:   contextContent,
+		Keywords:  stage.keywords,
+		Chunks:    toRetrievedChunks(stage.rankedChunks),
+		RagAnswer: answer,
 	}), nil
 }
 
+func (s *RagServer) runRerankStage(ctx context.Context, stage *contextStages) {
+	logger.Get().Debug("开始智能重排序",
+		slog.Int("chunks_before", len(stage.similarChunks)),
+	)
+	start := time.Now()
+	if isModelReranked(stage.similarChunks) {
+		// 搜索阶段已用重排序模型排序，跳过关键词启发式评分
+		stage.rankedChunks = stage.similarChunks[:min(len(stage.similarChunks), stage.rerankTopK())]
+	} else {
+		stage.rankedChunks = s.rerankChunksWithKeywords(stage.similarChunks, stage.query, stage.keywords, stage.rerankTopK(), stage.rerankMinSimilarity())
+	}
+
+	logger.Get().Info("重排序完成",
+		slog.Int("chunks_after", len(stage.rankedChunks)),
+		slog.Duration("rerank_duration", time.Since(start)),
+	)
+
+	if logger.Get().Enabled(ctx, slog.LevelDebug) && len(stage.rankedChunks) > 0 {
+		for i, chunk := range stage.rankedChunks {
+			if i < 3 { // Log top 3 reranked results
+				logger.Get().Debug("重排序结果",
+					slog.Int("final_rank", i+1),
+					slog.String("chunk_id", chunk.ChunkID),
+					slog.Float64("similarity", float64(chunk.Similarity)),
+					slog.Any("advanced_score", chunk.Metadata["advanced_score"]),
+					slog.Any("rerank_score", chunk.Metadata["rerank_score"]),
+				)
+			}
+		}
+	}
+}
+
+func (st *contextStages) rerankTopK() int {
+	if st.topK > 0 {
+		return st.topK
+	}
+	return defaultRerankTopK
+}
+
+func (st *contextStages) rerankMinSimilarity() float32 {
+	if st.minSimilarity > 0 {
+		return st.minSimilarity
+	}
+	return defaultRerankMinSimilarity
+}
+
+// systemPrompt returns the collection's summary prompt override, if any.
+func (st *contextStages) systemPrompt() string {
+	if st.collection == nil {
+		return ""
+	}
+	return st.collection.Settings.SystemPrompt
+}
+
+// candidateLimit is the number of chunks fetched by plain vector search,
+// leaving headroom for reranking to discard some.
+func (st *contextStages) candidateLimit() int {
+	return max(15, st.rerankTopK()*3)
+}
+
+// searchOptions translates request overrides into SearchOptimizer options.
+func (st *contextStages) searchOptions() []Option {
+	var opts []Option
+	if st.topK > 0 {
+		opts = append(opts, WithFinalResults(st.topK))
+	}
+	if st.minSimilarity > 0 {
+		opts = append(opts, WithMinSimilarity(float64(st.minSimilarity)))
+	}
+	if !st.filter.IsZero() {
+		opts = append(opts, WithFilter(st.filter))
+	}
+	if st.ann != (adapters.ANNParams{}) {
+		opts = append(opts, WithANNParams(st.ann))
+	}
+	return opts
+}
+
+// noResultsMessage is returned to the client when retrieval finds nothing.
+func noResultsMessage(query string) string {
+	return fmt.Sprintf("未找到与查询 '%s' 相关的内容。请尝试使用不同的关键词。", query)
+}
+
+// checkModel rejects a request-level model outside the LLM allowlist. An
+// empty model selects the task's configured model and is always accepted.
+func (s *RagServer) checkModel(model string) error {
+	if model == "" {
+		return nil
+	}
+	if s.LLM == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("LLM service is not configured"))
+	}
+	if err := s.LLM.CheckModel(model); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return nil
+}
+
+// toRetrievedChunks converts search results into their API representation,
+// carrying over the scores the search and rerank stages left in Metadata.
+func toRetrievedChunks(chunks []adapters.ChunkSearchResult) []*ragv1.RetrievedChunk {
+	retrieved := make([]*ragv1.RetrievedChunk, 0, len(chunks))
+	for _, chunk := range chunks {
+		hybridScore, _ := chunk.Metadata["hybrid_score"].(float64)
+		advancedScore, _ := chunk.Metadata["advanced_score"].(float64)
+		rerankScore, _ := chunk.Metadata["rerank_score"].(float64)
+		contextDropped, _ := chunk.Metadata["context_dropped"].(bool)
+
+		// JSON numbers come back from the metadata column as float64.
+		pageStart, _ := chunk.Metadata["page_start"].(float64)
+		pageEnd, _ := chunk.Metadata["page_end"].(float64)
+		charStart, _ := chunk.Metadata["char_start"].(float64)
+		charEnd, _ := chunk.Metadata["char_end"].(float64)
+
+		sectionTitle, _ := chunk.Metadata["section_title"].(string)
+		if sectionTitle == "" {
+			sectionTitle, _ = chunk.Metadata["chunk_title"].(string)
+		}
+
+		// 混合检索融合后 Similarity 为融合得分，这里仍返回向量余弦相似度
+		similarity := chunk.Similarity
+		if _, fused := chunk.Metadata["fusion"]; fused {
+			vectorSimilarity, _ := chunk.Metadata["vector_similarity"].(float64)
+			similarity = float32(vectorSimilarity)
+		}
+
+		retrieved = append(retrieved, &ragv1.RetrievedChunk{
+			DocumentId:     chunk.DocumentID,
+			ChunkId:        chunk.ChunkID,
+			ChunkIndex:     int32(chunk.ChunkIndex),
+			Title:          chunk.Title,
+			SectionTitle:   sectionTitle,
+			Similarity:     similarity,
+			HybridScore:    hybridScore,
+			AdvancedScore:  advancedScore,
+			RerankScore:    rerankScore,
+			Text:           chunk.Content,
+			PageStart:      int32(pageStart),
+			PageEnd:        int32(pageEnd),
+			CharStart:      int32(charStart),
+			CharEnd:        int32(charEnd),
+			ContextDropped: contextDropped,
+		})
+	}
+	return retrieved
+}
+
+// chunkPageLabel formats the page range recorded in chunk metadata, such
+// as "第 3 页" or "第 3-4 页"; it is empty when the pages are unknown.
+func chunkPageLabel(metadata map[string]any) string {
+	pageStart, _ := metadata["page_start"].(float64)
+	pageEnd, _ := metadata["page_end"].(float64)
+	switch {
+	case pageStart <= 0:
+		return ""
+	case pageEnd <= pageStart:
+		return fmt.Sprintf("第 %d 页", int(pageStart))
+	default:
+		return fmt.Sprintf("第 %d-%d 页", int(pageStart), int(pageEnd))
+	}
+}
+
 func (s *RagServer) runKeywordStage(ctx context.Context, stage *contextStages) error {
+	if stage.basicKeywords {
+		stage.keywords = pkgutils.ExtractBasicKeywords(stage.query)
+		logger.Get().Debug("使用本地分词提取关键词",
+			slog.Any("keywords", stage.keywords),
+		)
+		return nil
+	}
+	if s.LLM != nil && !s.LLM.Available(pkgopenai.TaskKeywords) {
+		stage.keywords = pkgutils.ExtractBasicKeywords(stage.query)
+		logger.Get().Warn("LLM 熔断中，使用本地分词提取关键词",
+			slog.Any("keywords", stage.keywords),
+		)
+		return nil
+	}
+
 	logger.Get().Debug("开始提取关键词", slog.String("query", stage.query))
 	start := time.Now()
-	keywords, err := s.generateKeywords(ctx, stage.query)
+	keywords, err := s.generateKeywords(ctx, stage.query, stage.model)
 	duration := time.Since(start)
 
 	if err != nil {
@@ -177,7 +372,10 @@ func (s *RagServer) runEmbeddingStage(ctx context.Context, stage *contextStages)
 
 	logger.Get().Debug("开始生成查询向量", slog.String("query_text", stage.queryText))
 	start := time.Now()
-	vec, err := s.generateEmbedding(ctx, stage.queryText)
+	// Queries must be embedded with the same model as the documents they
+	// are compared against.
+	model := s.embeddingModel(stage.collection)
+	vec, err := s.generateEmbedding(ctx, model, s.embeddingDimensions(model), stage.queryText)
 	duration := time.Since(start)
 
 	if err != nil {
@@ -204,13 +402,13 @@ func (s *RagServer) runSearchStage(ctx context.Context, stage *contextStages) er
 
 	if s.SearchOptimizer != nil {
 		logger.Get().Debug("使用优化搜索策略")
-		results, err = s.SearchOptimizer.OptimizedSearch(ctx, stage.query, stage.queryVector)
+		results, err = s.SearchOptimizer.OptimizedSearch(ctx, stage.query, stage.queryVector, stage.searchOptions()...)
 		if err != nil {
 			logger.Get().Error("优化搜索失败，回退到标准搜索",
 				slog.Any("error", err),
 				slog.Duration("failed_duration", time.Since(start)),
 			)
-			results, err = s.searchSimilarChunks(ctx, stage.queryVector, 15)
+			results, err = s.searchSimilarChunks(ctx, stage.queryVector, stage.candidateLimit(), stage.filter, stage.ann)
 			if err != nil {
 				logger.Get().Error("向量相似性搜索失败",
 					slog.Any("error", err),
@@ -221,7 +419,7 @@ func (s *RagServer) runSearchStage(ctx context.Context, stage *contextStages) er
 		}
 	} else {
 		logger.Get().Debug("使用标准向量搜索")
-		results, err = s.searchSimilarChunks(ctx, stage.queryVector, 15)
+		results, err = s.searchSimilarChunks(ctx, stage.queryVector, stage.candidateLimit(), stage.filter, stage.ann)
 		if err != nil {
 			logger.Get().Error("向量相似性搜索失败",
 				slog.Any("error", err),
@@ -260,13 +458,15 @@ func (s *RagServer) runSearchStage(ctx context.Context, stage *contextStages) er
 // the query vector, using cosine similarity to calculate relevance.
 // It returns the most relevant document fragments up to the specified limit.
 //
-// The similarity threshold of 0.3 filters out low-relevance results.
-func (s *RagServer) searchSimilarChunks(ctx context.Context, queryVector []float32, limit int) ([]adapters.ChunkSearchResult, error) {
+// The similarity threshold of 0.3 filters out low-relevance results,
+// filter restricts the search to matching documents and ann tunes the
+// vector index scan.
+func (s *RagServer) searchSimilarChunks(ctx context.Context, queryVector []float32, limit int, filter adapters.SearchFilter, ann adapters.ANNParams) ([]adapters.ChunkSearchResult, error) {
 	if s.DB == nil {
 		return nil, fmt.Errorf("database service is not initialized")
 	}
 	// 使用数据库的向量搜索功能
-	results, err := s.DB.SearchSimilarChunks(ctx, queryVector, limit, 0.3) // 0.3是相似度阈值
+	results, err := s.DB.SearchSimilarChunks(ctx, queryVector, limit, 0.3, filter, ann) // 0.3是相似度阈值
 	if err != nil {
 		return nil, fmt.Errorf("database search failed: %w", err)
 	}
@@ -382,6 +582,9 @@ func (s *RagServer) buildContextResponse(chunks []adapters.ChunkSearchResult, qu
 			if chunkType, ok := chunk.Metadata["chunk_type"].(string); ok && chunkType != "" {
 				contextBuilder.WriteString(fmt.Sprintf("*[内容类型: %s]*\n\n", chunkType))
 			}
+			if pages := chunkPageLabel(chunk.Metadata); pages != "" {
+				contextBuilder.WriteString(fmt.Sprintf("*[来源: %s %s]*\n\n", chunk.Title, pages))
+			}
 		}
 	}
 
@@ -409,140 +612,80 @@ func (s *RagServer) cleanAndFormatChunkContent(content string) string {
 // If the LLM call fails, it falls back to basic local tokenization using
 // utils.ExtractBasicKeywords.
 //
-// The function expects XML-formatted output from the LLM for structured parsing.
-func (s *RagServer) generateKeywords(_ context.Context, query string) ([]string, error) {
-	// Use prompt manager to get the keyword extraction prompt
-	if s.promptEmbeddingService != nil {
-		prompt, _, err := s.promptEmbeddingService.GetPromptWithEmbedding(prompts.PromptTypeKeywordExtraction)
-		if err == nil {
-			// Render the user prompt with the query
-			userContent, err := s.promptEmbeddingService.GetPromptManager().RenderUserPrompt(
-				prompts.PromptTypeKeywordExtraction,
-				map[string]string{"query": query},
-			)
-			if err == nil {
-				messages := []pkgopenai.Message{
-					{
-						Role:    "system",
-						Content: prompt.System,
-					},
-					{
-						Role:    "user",
-						Content: userContent,
-					},
-				}
-
-				if s.LLM == nil || s.Config == nil {
-					logger.Get().Warn("LLM service or config not initialized, falling back to basic kws (path 1)")
-					return pkgutils.ExtractBasicKeywords(query), nil
-				}
-
-				resp, err := s.LLM.CreateChatCompletionWithDefaults(s.Config.Services.LLM.Model, messages)
-				if err != nil {
-					logger.Get().Error("LLM关键词提取失败", slog.Any("error", err))
-					return pkgutils.ExtractBasicKeywords(query), nil
-				}
+// Providers with structured output answer with a JSON object decoded by
+// DecodeJSON; the others answer in XML, parsed by parseKeywordsXML.
+func (s *RagServer) generateKeywords(ctx context.Context, query, model string) ([]string, error) {
+	if s.LLM == nil || s.Config == nil {
+		logger.Get().Warn("LLM service or config not initialized, falling back to basic keywords")
+		return pkgutils.ExtractBasicKeywords(query), nil
+	}
 
-				if len(resp.Choices) == 0 {
-					return pkgutils.ExtractBasicKeywords(query), nil
-				}
+	pm := s.promptManager()
+	prompt, err := pm.GetPrompt(prompts.PromptTypeKeywordExtraction)
+	if err != nil {
+		logger.Get().Error("获取关键词提取提示词失败", slog.Any("error", err))
+		return pkgutils.ExtractBasicKeywords(query), nil
+	}
+	userContent, err := pm.RenderUserPrompt(
+		prompts.PromptTypeKeywordExtraction,
+		map[string]string{"query": query},
+	)
+	if err != nil {
+		logger.Get().Error("渲染关键词提取提示词失败", slog.Any("error", err))
+		return pkgutils.ExtractBasicKeywords(query), nil
+	}
 
-				logger.Get().Info("关键词 LLM", slog.Any("resp", resp))
-				content := resp.Choices[0].Message.Content
-				keywords := s.parseKeywordsXML(content)
-
-				if len(keywords) == 0 {
-					// Fallback to line-based parsing if XML parsing fails
-					lines := strings.Split(strings.TrimSpace(content), "\n")
-					for _, line := range lines {
-						keyword := strings.TrimSpace(line)
-						if strings.HasPrefix(keyword, "<") && strings.HasSuffix(keyword, ">") {
-							continue
-						}
-						if keyword != "" && len(keyword) > 1 {
-							keywords = append(keywords, keyword)
-						}
-					}
-				}
+	resp, err := s.completePrompt(ctx, pkgopenai.TaskKeywords, model, prompt, userContent)
+	if err != nil {
+		logger.Get().Error("LLM关键词提取失败", slog.Any("error", err))
+		// 降级为简单分词
+		return pkgutils.ExtractBasicKeywords(query), nil
+	}
+	if len(resp.Choices) == 0 {
+		return pkgutils.ExtractBasicKeywords(query), nil
+	}
 
-				if len(keywords) == 0 {
-					return pkgutils.ExtractBasicKeywords(query), nil
-				}
+	keywords := s.parseKeywords(resp)
+	if len(keywords) == 0 {
+		return pkgutils.ExtractBasicKeywords(query), nil
+	}
+	logger.Get().Debug("LLM 关键词提取完成",
+		slog.Any("keywords", keywords),
+		slog.Bool("structured", resp.Structured),
+	)
+	return keywords, nil
+}
 
-				return keywords, nil
-			}
+// parseKeywords reads the keywords from a JSON answer, or scrapes them from
+// the XML answer of a provider without structured output.
+func (s *RagServer) parseKeywords(resp *pkgopenai.StructuredResponse) []string {
+	content := resp.Choices[0].Message.Content
+	if resp.Structured {
+		output, err := pkgopenai.DecodeJSON[prompts.KeywordsOutput](content)
+		if err != nil {
+			logger.Get().Warn("结构化关键词解析失败", slog.Any("error", err))
+			return nil
 		}
+		return output.Keywords
 	}
 
-	// Fallback to direct prompt manager if prompt embedding service is not available
-	if pm := prompts.NewPromptManager(); pm != nil {
-		prompt, err := pm.GetPrompt(prompts.PromptTypeKeywordExtraction)
-		if err == nil {
-			userContent, err := pm.RenderUserPrompt(
-				prompts.PromptTypeKeywordExtraction,
-				map[string]string{"query": query},
-			)
-			if err == nil {
-				messages := []pkgopenai.Message{
-					{
-						Role:    "system",
-						Content: prompt.System,
-					},
-					{
-						Role:    "user",
-						Content: userContent,
-					},
-				}
-
-				if s.LLM == nil || s.Config == nil {
-					logger.Get().Warn("LLM service or config not initialized, falling back to basic kws (path 2)")
-					return pkgutils.ExtractBasicKeywords(query), nil
-				}
-
-				resp, err := s.LLM.CreateChatCompletionWithDefaults(s.Config.Services.LLM.Model, messages)
-
-				if err != nil {
-					logger.Get().Error("LLM关键词提取失败", slog.Any("error", err))
-					// 降级为简单分词
-					return pkgutils.ExtractBasicKeywords(query), nil
-				}
-
-				if len(resp.Choices) == 0 {
-					return pkgutils.ExtractBasicKeywords(query), nil
-				}
-
-				logger.Get().Info("关键词 LLM", slog.Any("resp", resp))
-				// 解析LLM返回的XML格式关键词
-				content := resp.Choices[0].Message.Content
-				keywords := s.parseKeywordsXML(content)
-
-				if len(keywords) == 0 {
-					// 如果XML解析失败，尝试按行解析（兼容旧格式）
-					lines := strings.Split(strings.TrimSpace(content), "\n")
-					for _, line := range lines {
-						keyword := strings.TrimSpace(line)
-						// 跳过XML标签
-						if strings.HasPrefix(keyword, "<") && strings.HasSuffix(keyword, ">") {
-							continue
-						}
-						if keyword != "" && len(keyword) > 1 {
-							keywords = append(keywords, keyword)
-						}
-					}
-				}
-
-				if len(keywords) == 0 {
-					return pkgutils.ExtractBasicKeywords(query), nil
-				}
-
-				return keywords, nil
+	// 解析LLM返回的XML格式关键词
+	keywords := s.parseKeywordsXML(content)
+	if len(keywords) == 0 {
+		// 如果XML解析失败，尝试按行解析（兼容旧格式）
+		lines := strings.Split(strings.TrimSpace(content), "\n")
+		for _, line := range lines {
+			keyword := strings.TrimSpace(line)
+			// 跳过XML标签
+			if strings.HasPrefix(keyword, "<") && strings.HasSuffix(keyword, ">") {
+				continue
+			}
+			if keyword != "" && len(keyword) > 1 {
+				keywords = append(keywords, keyword)
 			}
 		}
 	}
-
-	// Final fallback to basic keywords if all else fails
-	return pkgutils.ExtractBasicKeywords(query), nil
-
+	return keywords
 }
 
 // parseKeywordsXML parses XML-formatted keyword response from LLM.
@@ -602,9 +745,11 @@ func (s *RagServer) parseKeywordsXML(xmlContent string) []string {
 //   - Phrase matching (20% weight)
 //   - Content quality (10% weight)
 //
-// Parameters are configured with maxChunks=5 and minSimilarity=0.25.
-func (s *RagServer) rerankChunksWithKeywords(chunks []adapters.ChunkSearchResult, query string, keywords []string) []adapters.ChunkSearchResult {
-	return search.RerankChunksWithKeywords(chunks, query, keywords, 5, 0.25)
+// At most maxChunks results are kept, and only those whose vector
+// similarity is above minSimilarity; chunks found by keyword search alone
+// are kept regardless.
+func (s *RagServer) rerankChunksWithKeywords(chunks []adapters.ChunkSearchResult, query string, keywords []string, maxChunks int, minSimilarity float32) []adapters.ChunkSearchResult {
+	return search.RerankChunksWithKeywords(chunks, query, keywords, maxChunks, minSimilarity)
 }
 
 // calculateAdvancedChunkScore calculates multi-dimensional scoring for a chunk.
@@ -615,37 +760,120 @@ func (s *RagServer) calculateAdvancedChunkScore(chunk adapters.ChunkSearchResult
 	return search.CalculateAdvancedScore(chunk, query, keywords)
 }
 
+// contextSummaryFooter is appended to every LLM-generated summary.
+const contextSummaryFooter = "\n\n---\n\n提示: 以上回答基于知识库检索结果生成，如需了解更详细信息，可以尝试调整查询关键词或提出更具体的问题。"
+
 // generateContextSummary generates an intelligent context summary using LLM.
 //
 // Based on retrieved document chunks, this function uses the LLM to perform
 // deep analysis and intelligent summarization, generating high-quality,
 // structured responses tailored to the user's query.
 //
-// The function expects XML-formatted output from the LLM and falls back to
-// generateBasicContextSummary if the LLM is unavailable or returns an error.
-func (s *RagServer) generateContextSummary(ctx context.Context, chunks []adapters.ChunkSearchResult, query string) (string, error) {
+// Prior conversation turns in history are placed between the system prompt
+// and the current question so the LLM can resolve follow-up references.
+// A non-empty systemPrompt replaces the configured system prompt and a
+// non-empty model the providers' default models.
+//
+// The function expects XML-formatted output from the LLM, which is parsed
+// into the returned RagAnswer by parseRagAnswer, and falls back to
+// generateBasicContextSummary with a nil answer if the LLM is unavailable or
+// returns an error.
+func (s *RagServer) generateContextSummary(ctx context.Context, chunks []adapters.ChunkSearchResult, query, systemPrompt, model string, history []openai.Message) (string, *ragv1.RagAnswer, error) {
 	if len(chunks) == 0 {
-		return "", fmt.Errorf("no chunks to summarize")
+		return "", nil, fmt.Errorf("no chunks to summarize")
 	}
 
+	messages := s.buildContextSummaryMessages(chunks, query, systemPrompt)
+	// If prompt manager fails, return error
+	if len(messages) == 0 {
+		return s.generateBasicContextSummary(chunks, query), nil, nil
+	}
+	if len(history) > 0 {
+		// messages[0] is the system prompt; the rendered question goes last.
+		messages = append(append([]openai.Message{messages[0]}, history...), messages[1:]...)
+	}
+
+	// 调用LLM进行智能总结
+	if s.LLM == nil || s.Config == nil {
+		logger.Get().Warn("LLM service not initialized, falling back to basic summary")
+		return s.generateBasicContextSummary(chunks, query), nil, nil
+	}
+	resp, err := s.LLM.CreateChatCompletion(ctx, openai.TaskSummary, model, messages)
+	if err != nil {
+		logger.Get().Error("LLM智能总结失败，回退到基础模板", slog.Any("error", err))
+		// 降级到基础模板方案
+		return s.generateBasicContextSummary(chunks, query), nil, nil
+	}
+
+	if len(resp.Choices) == 0 || resp.Choices[0].Message.Content == "" {
+		logger.Get().Warn("LLM返回空内容，回退到基础模板")
+		return s.generateBasicContextSummary(chunks, query), nil, nil
+	}
+
+	// 只有默认提示词要求 XML 输出，自定义系统提示词时不重新生成
+	answer, intelligentSummary := s.parseRagAnswer(ctx, messages, model, resp.Choices[0].Message.Content, systemPrompt == "")
+
+	logger.Get().Info("LLM智能总结生成成功",
+		slog.String("query", query),
+		slog.Int("chunks_count", len(chunks)),
+		slog.Int("summary_length", len(intelligentSummary)),
+	)
+
+	// 添加系统标识和使用说明
+	return intelligentSummary + contextSummaryFooter, answer, nil
+}
+
+// buildContextSummaryMessages renders the context summary prompt for the
+// given chunks.
+//
+// It prefers the prompt embedding service and falls back to a fresh prompt
+// manager. An empty result means no prompt could be rendered. A non-empty
+// systemPrompt replaces the template's system message.
+func (s *RagServer) buildContextSummaryMessages(chunks []adapters.ChunkSearchResult, query, systemPrompt string) []openai.Message {
+	messages := s.renderContextSummaryMessages(chunks, query)
+	if len(messages) > 0 && systemPrompt != "" {
+		messages[0].Content = systemPrompt
+	}
+	return messages
+}
+
+func (s *RagServer) renderContextSummaryMessages(chunks []adapters.ChunkSearchResult, query string) []openai.Message {
 	// Build raw context for LLM analysis
 	rawContextBuilder := strings.Builder{}
 	rawContextBuilder.WriteString("以下是从知识库检索到的相关信息：\n\n")
 
-	for i, chunk := range chunks {
-		cleanContent := s.cleanAndFormatChunkContent(chunk.Content)
-		rawContextBuilder.WriteString(fmt.Sprintf("**信息片段%d (相似度: %.3f):**\n", i+1, chunk.Similarity))
-		rawContextBuilder.WriteString(cleanContent)
+	packed := s.packContext(chunks)
+	for i, passage := range packed.Passages {
+		first := chunks[passage.Chunks[0]]
+		last := chunks[passage.Chunks[len(passage.Chunks)-1]]
+		similarity := first.Similarity
+		for _, pos := range passage.Chunks[1:] {
+			similarity = max(similarity, chunks[pos].Similarity)
+		}
+		rawContextBuilder.WriteString(fmt.Sprintf("**信息片段%d (相似度: %.3f):**\n", i+1, similarity))
+		rawContextBuilder.WriteString(passage.Content)
+		if passage.Truncated {
+			rawContextBuilder.WriteString("……")
+		}
 
-		if chunk.Metadata != nil {
-			if chunkType, ok := chunk.Metadata["chunk_type"].(string); ok && chunkType != "" {
-				rawContextBuilder.WriteString(fmt.Sprintf("\n*[类型: %s]*", chunkType))
-			}
+		if chunkType, ok := first.Metadata["chunk_type"].(string); ok && chunkType != "" {
+			rawContextBuilder.WriteString(fmt.Sprintf("\n*[类型: %s]*", chunkType))
+		}
+		// 合并的相邻分块取首个分块的起始页和最后一个分块的结束页
+		pages := chunkPageLabel(map[string]any{
+			"page_start": first.Metadata["page_start"],
+			"page_end":   last.Metadata["page_end"],
+		})
+		if pages != "" {
+			rawContextBuilder.WriteString(fmt.Sprintf("\n*[来源: %s %s]*", first.Title, pages))
 		}
 		rawContextBuilder.WriteString("\n\n")
 	}
 
-	var messages []openai.Message
+	variables := map[string]string{
+		"query":   query,
+		"context": rawContextBuilder.String(),
+	}
 
 	// Try to use prompt manager for context summary
 	if s.promptEmbeddingService != nil {
@@ -653,13 +881,10 @@ func (s *RagServer) generateContextSummary(ctx context.Context, chunks []adapter
 		if err == nil {
 			userContent, err := s.promptEmbeddingService.GetPromptManager().RenderUserPrompt(
 				prompts.PromptTypeContextSummary,
-				map[string]string{
-					"query":   query,
-					"context": rawContextBuilder.String(),
-				},
+				variables,
 			)
 			if err == nil {
-				messages = []openai.Message{
+				return []openai.Message{
 					{
 						Role:    "system",
 						Content: prompt.System,
@@ -674,69 +899,26 @@ func (s *RagServer) generateContextSummary(ctx context.Context, chunks []adapter
 	}
 
 	// Fallback to direct prompt manager if prompt service is not available
-	if len(messages) == 0 {
-		if pm := prompts.NewPromptManager(); pm != nil {
-			prompt, err := pm.GetPrompt(prompts.PromptTypeContextSummary)
+	if pm := prompts.NewPromptManager(); pm != nil {
+		prompt, err := pm.GetPrompt(prompts.PromptTypeContextSummary)
+		if err == nil {
+			userContent, err := pm.RenderUserPrompt(prompts.PromptTypeContextSummary, variables)
 			if err == nil {
-				userContent, err := pm.RenderUserPrompt(
-					prompts.PromptTypeContextSummary,
-					map[string]string{
-						"query":   query,
-						"context": rawContextBuilder.String(),
+				return []openai.Message{
+					{
+						Role:    "system",
+						Content: prompt.System,
+					},
+					{
+						Role:    "user",
+						Content: userContent,
 					},
-				)
-				if err == nil {
-					messages = []openai.Message{
-						{
-							Role:    "system",
-							Content: prompt.System,
-						},
-						{
-							Role:    "user",
-							Content: userContent,
-						},
-					}
 				}
 			}
 		}
-		// If prompt manager fails, return error
-		if len(messages) == 0 {
-			return s.generateBasicContextSummary(chunks, query), nil
-		}
-	}
-
-	// 调用LLM进行智能总结
-	if s.LLM == nil || s.Config == nil {
-		logger.Get().Warn("LLM service not initialized, falling back to basic summary")
-		return s.generateBasicContextSummary(chunks, query), nil
-	}
-	resp, err := s.LLM.CreateChatCompletionWithDefaults(s.Config.Services.LLM.Model, messages)
-	if err != nil {
-		logger.Get().Error("LLM智能总结失败，回退到基础模板", slog.Any("error", err))
-		// 降级到基础模板方案
-		return s.generateBasicContextSummary(chunks, query), nil
-	}
-
-	if len(resp.Choices) == 0 || resp.Choices[0].Message.Content == "" {
-		logger.Get().Warn("LLM返回空内容，回退到基础模板")
-		return s.generateBasicContextSummary(chunks, query), nil
 	}
 
-	intelligentSummary := resp.Choices[0].Message.Content
-
-	// 添加系统标识和使用说明
-	var finalSummary strings.Builder
-	finalSummary.WriteString(intelligentSummary)
-	finalSummary.WriteString("\n\n---\n\n")
-	finalSummary.WriteString("提示: 以上回答基于知识库检索结果生成，如需了解更详细信息，可以尝试调整查询关键词或提出更具体的问题。")
-
-	logger.Get().Info("LLM智能总结生成成功",
-		slog.String("query", query),
-		slog.Int("chunks_count", len(chunks)),
-		slog.Int("summary_length", len(intelligentSummary)),
-	)
-
-	return finalSummary.String(), nil
+	return nil
 }
 
 // generateBasicContextSummary provides basic template-based summarization.
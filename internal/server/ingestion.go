@@ -0,0 +1,557 @@
+package server
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/hsn0918/rag/internal/adapters"
+	"github.com/hsn0918/rag/pkg/chunking"
+	pkgembedding "github.com/hsn0918/rag/pkg/clients/embedding"
+	"github.com/hsn0918/rag/pkg/config"
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/hsn0918/rag/pkg/parsers"
+	"go.uber.org/fx"
+)
+
+// Overall job progress reserved for each stage. Parsing and embedding report
+// fine-grained progress inside their ranges.
+const (
+	progressDownloading = 5
+	progressParsingFrom = 10
+	progressParsingTo   = 50
+	progressChunking    = 55
+	progressEmbedFrom   = 60
+	progressEmbedTo     = 99
+	progressCompleted   = 100
+)
+
+// IngestionQueue hands upload jobs from RPC handlers to the background
+// workers. Jobs are persisted in the JobStore; the wake channel only lets an
+// idle worker pick a new job up without waiting for its next poll.
+type IngestionQueue struct {
+	store adapters.JobStore
+	wake  chan struct{}
+}
+
+// NewIngestionQueue creates a queue backed by store.
+func NewIngestionQueue(store adapters.JobStore) *IngestionQueue {
+	return &IngestionQueue{
+		store: store,
+		wake:  make(chan struct{}, 1),
+	}
+}
+
+// Enqueue persists a new job and wakes an idle worker.
+func (q *IngestionQueue) Enqueue(ctx context.Context, input adapters.IngestionJobInput) (*adapters.IngestionJob, error) {
+	job, err := q.store.EnqueueIngestionJob(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return job, nil
+}
+
+// ingestionTracker records a running job's stage and progress in the store
+// so GetIngestionJob reflects it. Store errors are logged and otherwise
+// ignored: losing a progress update must not fail the ingestion itself.
+// Losing the job's lease does, through abort.
+type ingestionTracker struct {
+	store adapters.JobStore
+	job   *adapters.IngestionJob
+	// abort cancels the job's context when another worker took it over.
+	abort context.CancelCauseFunc
+}
+
+func (t *ingestionTracker) setStage(ctx context.Context, stage adapters.IngestionStage, progress int) {
+	t.job.Stage = stage
+	t.job.Progress = progress
+	t.save(ctx)
+}
+
+func (t *ingestionTracker) startEmbedding(ctx context.Context, totalChunks int) {
+	t.job.TotalChunks = totalChunks
+	t.setStage(ctx, adapters.IngestionStageEmbedding, progressEmbedFrom)
+}
+
+func (t *ingestionTracker) chunksDone(ctx context.Context, embedded, failed int) {
+	t.job.ProcessedChunks += embedded
+	t.job.FailedChunks += failed
+	done := t.job.ProcessedChunks + t.job.FailedChunks
+	if t.job.TotalChunks > 0 {
+		t.job.Progress = progressEmbedFrom + (progressEmbedTo-progressEmbedFrom)*done/t.job.TotalChunks
+	}
+	t.save(ctx)
+}
+
+func (t *ingestionTracker) save(ctx context.Context) {
+	err := t.store.UpdateIngestionJob(ctx, t.job)
+	switch {
+	case errors.Is(err, adapters.ErrJobLeaseLost):
+		logger.Get().Warn("摄取任务租约已失效", slog.String("job_id", t.job.ID), slog.Int("attempt", t.job.Attempts))
+		if t.abort != nil {
+			t.abort(err)
+		}
+	case err != nil:
+		logger.Get().Warn("更新摄取任务进度失败", slog.String("job_id", t.job.ID), slog.Any("error", err))
+	}
+}
+
+// heartbeat refreshes the job's lease until ctx is done, so the reaper does
+// not requeue a job whose stage runs longer than stale_after without a
+// progress update, such as semantic chunking. It aborts the job when the
+// lease was lost.
+func (t *ingestionTracker) heartbeat(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := t.store.TouchIngestionJob(ctx, t.job.ID, t.job.Attempts)
+			switch {
+			case errors.Is(err, adapters.ErrJobLeaseLost):
+				t.abort(err)
+				return
+			case err != nil && ctx.Err() == nil:
+				logger.Get().Warn("刷新摄取任务租约失败", slog.String("job_id", t.job.ID), slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// ingestDocument downloads, parses, chunks and embeds the job's file, storing the
+// document and its chunks. It is the body UploadPdf used to run inline.
+func (s *RagServer) ingestDocument(ctx context.Context, tracker *ingestionTracker) error {
+	job := tracker.job
+	// Documents are stored in the table set active now; if a re-index cuts
+	// over while the job runs, the store fails and the job is requeued.
+	tableSet := s.DB.ActiveTableSet().Name
+
+	// The collection may have been deleted while the job was queued.
+	var collection *adapters.Collection
+	if job.CollectionID != "" {
+		var err error
+		collection, err = s.DB.GetCollection(ctx, job.CollectionID)
+		if err != nil {
+			return fmt.Errorf("failed to load collection %s: %w", job.CollectionID, err)
+		}
+	}
+	embeddingModel := s.embeddingModel(collection)
+	embeddingDimensions := s.embeddingDimensions(embeddingModel)
+
+	tracker.setStage(ctx, adapters.IngestionStageDownloading, progressDownloading)
+	exists, err := s.Storage.CheckFileExists(ctx, job.FileKey)
+	if err != nil {
+		return fmt.Errorf("failed to check file existence: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("file not found in storage: %s", job.FileKey)
+	}
+	object, err := s.Storage.DownloadFile(ctx, job.FileKey)
+	if err != nil {
+		return fmt.Errorf("failed to download file: %w", err)
+	}
+	defer object.Close()
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return fmt.Errorf("failed to read file data: %w", err)
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("file is empty")
+	}
+
+	parser, err := s.Parsers.Lookup(job.Filename, job.ContentType)
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, job.Filename)
+	}
+
+	md5Hash := fmt.Sprintf("%x", md5.Sum(data))
+
+	// UploadDocument already checked for duplicates, but an identical upload may
+	// have been ingested since; re-check before doing the expensive work.
+	if job.DedupePolicy != adapters.DedupeReplace {
+		existing, err := s.DB.FindDocumentByContentHash(ctx, job.CollectionID, md5Hash)
+		switch {
+		case errors.Is(err, adapters.ErrDocumentNotFound):
+		case err != nil:
+			return fmt.Errorf("failed to look up duplicate document: %w", err)
+		case job.DedupePolicy == adapters.DedupeReject:
+			return fmt.Errorf("duplicate of existing document %s", existing.ID)
+		default:
+			logger.Get().Info("内容与已有文档重复，直接复用",
+				slog.String("job_id", job.ID),
+				slog.String("document_id", existing.ID),
+			)
+			job.DocumentID = existing.ID
+			return nil
+		}
+	}
+
+	tracker.setStage(ctx, adapters.IngestionStageParsing, progressParsingFrom)
+	parsed, err := parser.Parse(ctx, parsers.Input{
+		Filename:    job.Filename,
+		ContentType: job.ContentType,
+		Data:        data,
+		OnProgress: func(progress int) {
+			tracker.setStage(ctx, adapters.IngestionStageParsing,
+				progressParsingFrom+(progressParsingTo-progressParsingFrom)*progress/100)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to parse document with %s parser: %w", parser.Name(), err)
+	}
+	if parsed.Content == "" {
+		return fmt.Errorf("no text extracted from document")
+	}
+
+	textContent := s.cleanEmptyLines(parsed.Content)
+
+	tracker.setStage(ctx, adapters.IngestionStageChunking, progressChunking)
+	chunks, err := s.chunkTextContent(ctx, textContent, s.chunkingConfig(collection), embeddingModel, embeddingDimensions)
+	if err != nil {
+		return fmt.Errorf("failed to chunk text: %w", err)
+	}
+
+	// Embed every chunk before touching the database so the document and
+	// its chunks can be written in a single transaction.
+	tracker.startEmbedding(ctx, len(chunks))
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = s.cleanText(chunk.Content)
+	}
+
+	embeddings, failedChunks, err := s.embedTexts(ctx, embeddingModel, embeddingDimensions, texts, func(embedded, failed int) {
+		tracker.chunksDone(ctx, embedded, failed)
+	})
+	if err != nil {
+		return err
+	}
+	records := chunkRecords(chunks, texts, embeddings)
+
+	if len(chunks) > 0 && len(records) == 0 {
+		return fmt.Errorf("all %d chunks failed to embed", len(chunks))
+	}
+
+	docMetadata := map[string]any{
+		"source":     job.Filename,
+		"pages":      parsed.Pages,
+		"parser":     parser.Name(),
+		"md5_hash":   md5Hash,
+		"created_at": time.Now(),
+		// Queries embed with the collection's model, so record which one
+		// produced these vectors.
+		"embedding_model": embeddingModel,
+	}
+	if job.ContentType != "" {
+		docMetadata["content_type"] = job.ContentType
+	}
+	if len(job.Tags) > 0 {
+		docMetadata["tags"] = job.Tags
+	}
+	if job.Uploader != "" {
+		docMetadata["uploader"] = job.Uploader
+	}
+	if parsed.Extractor != "" {
+		docMetadata["extractor"] = parsed.Extractor
+	}
+	if parsed.Extractor == extractorDoc2X {
+		docMetadata["doc2x_uid"] = fmt.Sprintf("processed_%s", md5Hash)
+	}
+	if len(failedChunks) > 0 {
+		docMetadata["failed_chunks"] = failedChunks
+	}
+
+	docID, err := s.DB.StoreDocumentWithChunks(ctx, adapters.DocumentInput{
+		Title:           job.Filename,
+		MinioKey:        job.FileKey,
+		ContentHash:     md5Hash,
+		Metadata:        docMetadata,
+		CollectionID:    job.CollectionID,
+		TableSet:        tableSet,
+		ReplaceExisting: job.DedupePolicy == adapters.DedupeReplace,
+	}, records)
+	var duplicate *adapters.DuplicateDocumentError
+	if errors.As(err, &duplicate) {
+		// Another worker stored the same content after the check above.
+		if job.DedupePolicy == adapters.DedupeReject {
+			return duplicate
+		}
+		logger.Get().Info("内容与并发写入的文档重复，直接复用",
+			slog.String("job_id", job.ID),
+			slog.String("document_id", duplicate.ExistingID),
+		)
+		job.DocumentID = duplicate.ExistingID
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store document: %w", err)
+	}
+	job.DocumentID = docID
+
+	// Cache the document information.
+	err = s.Cache.CacheDocument(ctx, docID, map[string]any{
+		"title":    job.Filename,
+		"content":  textContent,
+		"parser":   parser.Name(),
+		"md5_hash": md5Hash,
+		"chunks":   len(chunks),
+	})
+	if err != nil {
+		logger.Get().Warn("Failed to cache document", slog.String("doc_id", docID), slog.Any("error", err))
+	}
+
+	return nil
+}
+
+// embedTexts embeds texts with model at the given output size. When ingestion allows partial results,
+// texts that failed to embed get a nil embedding and are reported by index
+// instead of failing the whole call.
+func (s *RagServer) embedTexts(ctx context.Context, model string, dimensions int, texts []string, onProgress func(embedded, failed int)) ([][]float32, []int, error) {
+	embeddings, err := s.generateEmbeddings(ctx, model, dimensions, texts, onProgress)
+	if err == nil {
+		return embeddings, nil, nil
+	}
+	var batchErr *pkgembedding.BatchError
+	if !errors.As(err, &batchErr) || !s.Config.Ingestion.AllowPartial {
+		return nil, nil, fmt.Errorf("failed to generate embeddings: %w", err)
+	}
+	logger.Get().Error("Failed to generate embeddings for some chunks",
+		slog.Any("failed_chunks", batchErr.Failed),
+		slog.Any("error", batchErr.Err),
+	)
+	return embeddings, batchErr.Failed, nil
+}
+
+// chunkRecords builds the stored records for chunks, skipping chunks that
+// have no embedding. texts holds the cleaned content of each chunk.
+func chunkRecords(chunks []chunking.Chunk, texts []string, embeddings [][]float32) []adapters.ChunkRecord {
+	records := make([]adapters.ChunkRecord, 0, len(chunks))
+	for i, chunk := range chunks {
+		if embeddings[i] == nil {
+			continue
+		}
+
+		metadata := make(map[string]any)
+		for k, v := range chunk.Metadata {
+			metadata[k] = v
+		}
+		metadata["chunk_length"] = len(texts[i])
+		metadata["chunk_type"] = chunk.Type
+		metadata["chunk_title"] = chunk.Title
+		metadata["char_start"] = chunk.StartIndex
+		metadata["char_end"] = chunk.EndIndex
+		if chunk.PageStart > 0 {
+			metadata["page_start"] = chunk.PageStart
+			metadata["page_end"] = chunk.PageEnd
+		}
+
+		records = append(records, adapters.ChunkRecord{
+			Index:     i,
+			Content:   texts[i],
+			Embedding: embeddings[i],
+			Metadata:  metadata,
+		})
+	}
+	return records
+}
+
+// IngestionWorkers processes queued ingestion jobs in the background with
+// a fixed number of goroutines.
+type IngestionWorkers struct {
+	server *RagServer
+	queue  *IngestionQueue
+	cfg    config.IngestionConfig
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewIngestionWorkers creates the worker pool; call Start to run it.
+func NewIngestionWorkers(server *RagServer, queue *IngestionQueue, cfg config.IngestionConfig) *IngestionWorkers {
+	return &IngestionWorkers{server: server, queue: queue, cfg: cfg}
+}
+
+// Start requeues jobs abandoned by a previous process and launches the
+// workers. Workers run until Stop is called.
+func (w *IngestionWorkers) Start(ctx context.Context) error {
+	if _, err := w.queue.store.RequeueStaleIngestionJobs(ctx, w.cfg.StaleAfter, w.cfg.MaxAttempts); err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	for i := 0; i < w.cfg.Workers; i++ {
+		workerID := i
+		w.wg.Go(func() {
+			w.run(runCtx, workerID)
+		})
+	}
+	w.wg.Go(func() {
+		w.reapStale(runCtx)
+	})
+
+	logger.Get().Info("摄取任务 worker 已启动",
+		slog.Int("workers", w.cfg.Workers),
+		slog.Duration("poll_interval", w.cfg.PollInterval),
+	)
+	return nil
+}
+
+// Stop cancels running jobs, which return to the queue, and waits for the
+// workers to exit or ctx to expire.
+func (w *IngestionWorkers) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *IngestionWorkers) run(ctx context.Context, workerID int) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := w.queue.store.ClaimIngestionJob(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Get().Error("领取摄取任务失败", slog.Int("worker", workerID), slog.Any("error", err))
+		}
+		if job != nil {
+			w.process(ctx, workerID, job)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.queue.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *IngestionWorkers) process(ctx context.Context, workerID int, job *adapters.IngestionJob) {
+	start := time.Now()
+	logger.Get().Info("开始处理摄取任务",
+		slog.Int("worker", workerID),
+		slog.String("job_id", job.ID),
+		slog.String("file_key", job.FileKey),
+		slog.Int("attempt", job.Attempts),
+	)
+
+	jobCtx, abort := context.WithCancelCause(ctx)
+	tracker := &ingestionTracker{store: w.queue.store, job: job, abort: abort}
+	var heartbeat sync.WaitGroup
+	heartbeat.Go(func() {
+		tracker.heartbeat(jobCtx, w.cfg.StaleAfter/3)
+	})
+	err := w.server.ingestDocument(jobCtx, tracker)
+	abort(nil)
+	heartbeat.Wait()
+
+	if errors.Is(context.Cause(jobCtx), adapters.ErrJobLeaseLost) {
+		// The reaper requeued the job and another worker may be running
+		// it; its state is no longer ours to write.
+		logger.Get().Warn("摄取任务租约已失效，放弃处理",
+			slog.String("job_id", job.ID),
+			slog.Int("attempt", job.Attempts),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	// Progress updates above use ctx; the final state must be written even
+	// when ctx was cancelled by shutdown.
+	saveCtx := context.WithoutCancel(ctx)
+
+	if err != nil && (ctx.Err() != nil || errors.Is(err, adapters.ErrTableSetChanged)) {
+		// Interrupted by shutdown, or by a re-index cutover, before the
+		// document was committed: hand the job back so it starts again from
+		// scratch against the active table set.
+		job.Status = adapters.JobStatusQueued
+		job.Stage = adapters.IngestionStageQueued
+		job.Progress = 0
+		job.ProcessedChunks, job.FailedChunks, job.TotalChunks = 0, 0, 0
+		tracker.save(saveCtx)
+		logger.Get().Warn("摄取任务被中断，已重新排队", slog.String("job_id", job.ID), slog.Any("error", err))
+		return
+	}
+
+	now := time.Now()
+	job.FinishedAt = &now
+	if err != nil {
+		job.Status = adapters.JobStatusFailed
+		job.Error = err.Error()
+		logger.Get().Error("摄取任务失败",
+			slog.String("job_id", job.ID),
+			slog.Any("error", err),
+			slog.Duration("duration", time.Since(start)),
+		)
+	} else {
+		job.Status = adapters.JobStatusSucceeded
+		job.Stage = adapters.IngestionStageCompleted
+		job.Progress = progressCompleted
+		logger.Get().Info("摄取任务完成",
+			slog.String("job_id", job.ID),
+			slog.String("document_id", job.DocumentID),
+			slog.Int("chunks", job.ProcessedChunks),
+			slog.Int("failed_chunks", job.FailedChunks),
+			slog.Duration("duration", time.Since(start)),
+		)
+	}
+	tracker.save(saveCtx)
+}
+
+// reapStale periodically requeues jobs whose worker stopped reporting
+// progress, e.g. because another instance crashed mid-job.
+func (w *IngestionWorkers) reapStale(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.StaleAfter / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := w.queue.store.RequeueStaleIngestionJobs(ctx, w.cfg.StaleAfter, w.cfg.MaxAttempts)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				logger.Get().Error("回收停滞摄取任务失败", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// StartIngestionWorkers 启动后台摄取任务 worker
+func StartIngestionWorkers(server *RagServer, cfg *config.Config, lifecycle fx.Lifecycle) {
+	workers := NewIngestionWorkers(server, server.Ingestion, cfg.Ingestion)
+	lifecycle.Append(fx.Hook{
+		OnStart: workers.Start,
+		OnStop: func(ctx context.Context) error {
+			logger.Get().Info("停止摄取任务 worker")
+			return workers.Stop(ctx)
+		},
+	})
+}
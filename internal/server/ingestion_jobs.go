@@ -0,0 +1,105 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hsn0918/rag/internal/adapters"
+	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
+)
+
+var (
+	jobStatusToProto = map[adapters.JobStatus]ragv1.IngestionJobStatus{
+		adapters.JobStatusQueued:    ragv1.IngestionJobStatus_INGESTION_JOB_STATUS_QUEUED,
+		adapters.JobStatusRunning:   ragv1.IngestionJobStatus_INGESTION_JOB_STATUS_RUNNING,
+		adapters.JobStatusSucceeded: ragv1.IngestionJobStatus_INGESTION_JOB_STATUS_SUCCEEDED,
+		adapters.JobStatusFailed:    ragv1.IngestionJobStatus_INGESTION_JOB_STATUS_FAILED,
+	}
+	jobStatusFromProto = map[ragv1.IngestionJobStatus]adapters.JobStatus{
+		ragv1.IngestionJobStatus_INGESTION_JOB_STATUS_QUEUED:    adapters.JobStatusQueued,
+		ragv1.IngestionJobStatus_INGESTION_JOB_STATUS_RUNNING:   adapters.JobStatusRunning,
+		ragv1.IngestionJobStatus_INGESTION_JOB_STATUS_SUCCEEDED: adapters.JobStatusSucceeded,
+		ragv1.IngestionJobStatus_INGESTION_JOB_STATUS_FAILED:    adapters.JobStatusFailed,
+	}
+	ingestionStageToProto = map[adapters.IngestionStage]ragv1.IngestionStage{
+		adapters.IngestionStageQueued:      ragv1.IngestionStage_INGESTION_STAGE_QUEUED,
+		adapters.IngestionStageDownloading: ragv1.IngestionStage_INGESTION_STAGE_DOWNLOADING,
+		adapters.IngestionStageParsing:     ragv1.IngestionStage_INGESTION_STAGE_PARSING,
+		adapters.IngestionStageChunking:    ragv1.IngestionStage_INGESTION_STAGE_CHUNKING,
+		adapters.IngestionStageEmbedding:   ragv1.IngestionStage_INGESTION_STAGE_EMBEDDING,
+		adapters.IngestionStageCompleted:   ragv1.IngestionStage_INGESTION_STAGE_COMPLETED,
+	}
+)
+
+// GetIngestionJob 查询摄取任务的状态与进度
+func (s *RagServer) GetIngestionJob(
+	ctx context.Context,
+	req *connect.Request[ragv1.GetIngestionJobRequest],
+) (*connect.Response[ragv1.GetIngestionJobResponse], error) {
+	job, err := s.Ingestion.store.GetIngestionJob(ctx, req.Msg.GetJobId())
+	if errors.Is(err, adapters.ErrJobNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ragv1.GetIngestionJobResponse{
+		Job: toProtoIngestionJob(job),
+	}), nil
+}
+
+// ListIngestionJobs 列出摄取任务（按创建时间倒序，游标分页）
+func (s *RagServer) ListIngestionJobs(
+	ctx context.Context,
+	req *connect.Request[ragv1.ListIngestionJobsRequest],
+) (*connect.Response[ragv1.ListIngestionJobsResponse], error) {
+	pageSize := int(req.Msg.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+
+	jobs, nextCursor, err := s.Ingestion.store.ListIngestionJobs(ctx, pageSize, req.Msg.GetCursor(), jobStatusFromProto[req.Msg.GetStatus()])
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	out := make([]*ragv1.IngestionJob, 0, len(jobs))
+	for i := range jobs {
+		out = append(out, toProtoIngestionJob(&jobs[i]))
+	}
+
+	return connect.NewResponse(&ragv1.ListIngestionJobsResponse{
+		Jobs:       out,
+		NextCursor: nextCursor,
+	}), nil
+}
+
+func toProtoIngestionJob(job *adapters.IngestionJob) *ragv1.IngestionJob {
+	var finishedAt string
+	if job.FinishedAt != nil {
+		finishedAt = job.FinishedAt.UTC().Format(time.RFC3339)
+	}
+	return &ragv1.IngestionJob{
+		Id:              job.ID,
+		FileKey:         job.FileKey,
+		Filename:        job.Filename,
+		Status:          jobStatusToProto[job.Status],
+		Stage:           ingestionStageToProto[job.Stage],
+		Progress:        int32(job.Progress),
+		TotalChunks:     int32(job.TotalChunks),
+		ProcessedChunks: int32(job.ProcessedChunks),
+		FailedChunks:    int32(job.FailedChunks),
+		DocumentId:      job.DocumentID,
+		Error:           job.Error,
+		Attempts:        int32(job.Attempts),
+		CreatedAt:       job.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:       job.UpdatedAt.UTC().Format(time.RFC3339),
+		FinishedAt:      finishedAt,
+		CollectionId:    job.CollectionID,
+		Tags:            job.Tags,
+		Uploader:        job.Uploader,
+	}
+}
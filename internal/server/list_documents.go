@@ -20,7 +20,7 @@ func (s *RagServer) ListDocuments(
 	}
 	cursor := req.Msg.GetCursor()
 
-	docs, nextCursor, err := s.DB.ListDocuments(ctx, pageSize, cursor)
+	docs, nextCursor, err := s.DB.ListDocuments(ctx, req.Msg.GetCollectionId(), pageSize, cursor)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -41,6 +41,7 @@ func (s *RagServer) ListDocuments(
 			MinioKey:     d.MinioKey,
 			MetadataJson: metadataJSON,
 			CreatedAt:    createdAt,
+			CollectionId: d.CollectionID,
 		})
 	}
 
@@ -10,6 +10,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/hsn0918/rag/internal/adapters"
 	"github.com/hsn0918/rag/internal/gen/rag/v1/ragv1connect"
+	"github.com/hsn0918/rag/pkg/clients/base"
 	pkgdoc2x "github.com/hsn0918/rag/pkg/clients/doc2x"
 	pkgembedding "github.com/hsn0918/rag/pkg/clients/embedding"
 	pkgopenai "github.com/hsn0918/rag/pkg/clients/openai"
@@ -17,6 +18,7 @@ import (
 	"github.com/hsn0918/rag/pkg/config"
 	"github.com/hsn0918/rag/pkg/logger"
 	"github.com/hsn0918/rag/pkg/middleware"
+	"github.com/hsn0918/rag/pkg/parsers"
 	"github.com/hsn0918/rag/pkg/redis"
 	"github.com/hsn0918/rag/pkg/storage"
 	"go.uber.org/fx"
@@ -37,6 +39,8 @@ var Module = fx.Options(
 	// HTTP服务器模块
 	HTTPServerModule,
 	// 启动器
+	fx.Invoke(StartIngestionWorkers),
+	fx.Invoke(StartReindexer),
 	fx.Invoke(StartHTTPServer),
 )
 
@@ -46,6 +50,11 @@ var InfrastructureModule = fx.Module("infrastructure",
 		NewAppConfig,
 		NewAppLogger,
 		NewVectorDatabase,
+		NewVectorDB,
+		NewTableSetStore,
+		NewJobStore,
+		NewIngestionJobStore,
+		NewReindexJobStore,
 		NewRedisConnection,
 		NewCacheService,
 	),
@@ -61,6 +70,7 @@ var ClientsModule = fx.Module("clients",
 // ServicesModule 服务模块 - 业务逻辑服务
 var ServicesModule = fx.Module("services",
 	fx.Provide(
+		NewIngestionQueue,
 		NewRagService,
 	),
 )
@@ -82,6 +92,14 @@ func NewAppConfig() (*config.Config, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
+
+	// 向量维度需对照模型支持的列表校验，config 包不依赖各客户端，在此完成
+	embeddingCfg := &cfg.Services.Embedding
+	dimensions, err := pkgembedding.ResolveDimensions(embeddingCfg.Model, embeddingCfg.Dimensions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: services.embedding.dimensions: %w", err)
+	}
+	embeddingCfg.Dimensions = dimensions
 	return cfg, nil
 }
 
@@ -93,29 +111,79 @@ func NewAppLogger() (*slog.Logger, error) {
 	return logger.Get(), nil
 }
 
-// NewVectorDatabase 创建向量数据库连接
-func NewVectorDatabase(cfg *config.Config) (adapters.VectorDB, error) {
-	dsn := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable",
+// databaseDSN 根据配置生成 PostgreSQL 连接字符串
+func databaseDSN(cfg *config.Config) string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable",
 		cfg.Database.User,
 		cfg.Database.Password,
 		cfg.Database.Host,
 		cfg.Database.Port,
 		cfg.Database.DBName,
 	)
+}
+
+// NewVectorDatabase 创建向量数据库连接
+func NewVectorDatabase(cfg *config.Config) (*adapters.PostgresVectorDB, error) {
+	dsn := databaseDSN(cfg)
 
 	embeddingModel := cfg.Services.Embedding.Model
-	dimensions := pkgembedding.GetDefaultDimensions(embeddingModel)
+	dimensions := cfg.Services.Embedding.Dimensions
 	logger.Get().Info("初始化向量数据库",
 		"model", embeddingModel,
 		"dimensions", dimensions)
+	if twoStage := cfg.VectorIndex.TwoStageDimensions; twoStage > 0 && twoStage >= dimensions {
+		logger.Get().Warn("两阶段检索维度不小于向量维度，将直接索引完整向量",
+			"two_stage_dimensions", twoStage,
+			"dimensions", dimensions)
+	}
 
-	db, err := adapters.NewPostgresVectorDB(dsn, dimensions)
+	db, err := adapters.NewPostgresVectorDB(dsn, embeddingModel, dimensions, adapters.VectorIndexOptions{
+		Type:           cfg.VectorIndex.Type,
+		M:              cfg.VectorIndex.M,
+		EfConstruction: cfg.VectorIndex.EfConstruction,
+		Lists:          cfg.VectorIndex.Lists,
+		EfSearch:       cfg.VectorIndex.EfSearch,
+		Probes:         cfg.VectorIndex.Probes,
+		IterativeScan:  cfg.VectorIndex.IterativeScan,
+
+		TwoStageDimensions: cfg.VectorIndex.TwoStageDimensions,
+		RescoreFactor:      cfg.VectorIndex.RescoreFactor,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create vector database: %w", err)
 	}
 	return db, nil
 }
 
+// NewVectorDB 提供向量数据库接口
+func NewVectorDB(db *adapters.PostgresVectorDB) adapters.VectorDB {
+	return db
+}
+
+// NewTableSetStore 提供表集管理接口
+func NewTableSetStore(db *adapters.PostgresVectorDB) adapters.TableSetStore {
+	return db
+}
+
+// NewJobStore 创建摄取任务与重建任务存储
+func NewJobStore(cfg *config.Config) (*adapters.PostgresJobStore, error) {
+	store, err := adapters.NewPostgresJobStore(databaseDSN(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job store: %w", err)
+	}
+	return store, nil
+}
+
+// NewIngestionJobStore 提供摄取任务存储接口
+func NewIngestionJobStore(store *adapters.PostgresJobStore) adapters.JobStore {
+	return store
+}
+
+// NewReindexJobStore 提供重建任务存储接口
+func NewReindexJobStore(store *adapters.PostgresJobStore) adapters.ReindexJobStore {
+	return store
+}
+
 // NewRedisConnection 创建Redis连接
 func NewRedisConnection(cfg *config.Config) (*redis.Client, error) {
 	client, err := redis.NewClientFromConfig(*cfg)
@@ -148,11 +216,16 @@ func NewClients(cfg *config.Config) (*ExternalClients, error) {
 		return nil, fmt.Errorf("failed to create MinIO client: %w", err)
 	}
 
+	llmRouter, err := pkgopenai.NewRouter(cfg.Services.LLM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LLM router: %w", err)
+	}
+
 	return &ExternalClients{
-		Doc2X:     pkgdoc2x.NewClient(cfg.Services.Doc2X),
+		Doc2X:     pkgdoc2x.NewClient(cfg.Services.Doc2X.ServiceConfig, pkgdoc2x.WithMaxWait(cfg.Services.Doc2X.MaxWait)),
 		Embedding: pkgembedding.NewClient(cfg.Services.Embedding.ServiceConfig),
-		LLM:       pkgopenai.NewClient(cfg.Services.LLM),
-		Reranker:  pkgrerank.NewClient(cfg.Services.Reranker),
+		LLM:       llmRouter,
+		Reranker:  pkgrerank.NewClient(cfg.Services.Reranker.ServiceConfig),
 		Storage:   minioClient,
 	}, nil
 }
@@ -169,14 +242,19 @@ func NewExternalClients(cfg *config.Config) (*ExternalClients, error) {
 // NewRagService 创建RAG服务
 func NewRagService(
 	db adapters.VectorDB,
+	tableSets adapters.TableSetStore,
+	reindexJobs adapters.ReindexJobStore,
 	cache *redis.CacheService,
 	clients *ExternalClients,
+	ingestion *IngestionQueue,
 	cfg *config.Config,
 ) (*RagServer, error) {
 	// 创建RAG服务实例
 	server := &RagServer{
 		DB:        db,
+		TableSets: tableSets,
 		Cache:     cache,
+		Ingestion: ingestion,
 		Storage:   clients.Storage,
 		Doc2X:     clients.Doc2X,
 		Embedding: clients.Embedding,
@@ -185,13 +263,37 @@ func NewRagService(
 		Config:    cfg,
 	}
 
+	// 注册文档解析器：PDF 按配置走 Doc2X 或本地提取，其余格式在本地转换
+	server.Parsers = parsers.NewRegistry()
+	server.Parsers.Register(pdfParser{server: server}, []string{".pdf"}, []string{"application/pdf"})
+
+	// 重建索引复用摄取任务的轮询与停滞判定间隔
+	server.Reindex = NewReindexer(server, tableSets, reindexJobs, cfg.Ingestion)
+
 	// 初始化搜索优化器
+	fusion, err := NewFusionStrategy(
+		cfg.Search.Fusion,
+		cfg.Search.RRFK,
+		cfg.Search.VectorWeight,
+		cfg.Search.KeywordWeight,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fusion strategy: %w", err)
+	}
+	searchOpts := []Option{
+		WithMinSimilarity(0.25),
+		WithParallelScoring(true),
+		WithFusion(fusion),
+	}
+	if cfg.Services.Reranker.Enabled && clients.Reranker != nil {
+		// 启用重排序模型，替代关键词启发式评分
+		searchOpts = append(searchOpts, WithReranker(clients.Reranker, cfg.Services.Reranker.Model))
+	}
 	searchOptimizer, err := NewSearchOptimizer(
 		server,
 		20, // 初始候选数
 		5,  // 最终结果数
-		WithMinSimilarity(0.25),
-		WithParallelScoring(true),
+		searchOpts...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create search optimizer: %w", err)
@@ -226,6 +328,8 @@ func NewHTTPHandler(ragService *RagServer, cfg *config.Config) *http.Server {
 	// 注册RPC服务处理器
 	path, handler := ragv1connect.NewRagServiceHandler(ragService, connectOpts...)
 	mux.Handle(path, handler)
+	// 外部服务的熔断、限流与重试统计
+	mux.Handle("GET /metrics/services", base.StatsHandler())
 
 	serverAddr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
 	logger.Get().Info("HTTP服务器配置完成", "address", serverAddr)
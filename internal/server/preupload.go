@@ -19,6 +19,10 @@ func (s *RagServer) PreUpload(
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filename is required"))
 	}
 
+	if _, err := s.Parsers.Lookup(filename, ""); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s", err, filename))
+	}
+
 	// 生成唯一的对象键
 	objectKey, err := s.generateObjectKey(filename)
 	if err != nil {
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
+	"github.com/hsn0918/rag/pkg/clients/openai"
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/hsn0918/rag/pkg/prompts"
+)
+
+// ragAnswerReaskPrompt asks the LLM once more for a well-formed document
+// after its summary could not be parsed, even with repairs.
+const ragAnswerReaskPrompt = "你上一次的输出不是合法的 <rag_response> XML 文档（%v）。请基于同样的上下文重新输出，只输出结构完整的 XML 文档，不要包含任何解释或代码块标记。"
+
+// parseRagAnswer parses the summary the LLM produced for messages into a
+// RagAnswer. Malformed output is repaired locally first; when that fails
+// and reask is set, the LLM is asked a single time to re-emit the document.
+//
+// It returns the answer, or nil when the output stays unparseable, and the
+// text the answer was parsed from, which is the re-asked output when the
+// re-ask succeeded and text otherwise.
+func (s *RagServer) parseRagAnswer(ctx context.Context, messages []openai.Message, model, text string, reask bool) (*ragv1.RagAnswer, string) {
+	resp, err := prompts.ParseRAGResponse(text)
+	if err == nil {
+		if resp.Repaired {
+			logger.Get().Info("总结 XML 格式不合法，已自动修复")
+		}
+		return toRagAnswer(resp), text
+	}
+	if !reask || s.LLM == nil {
+		logger.Get().Debug("总结不是 rag_response 文档，返回原始文本", slog.Any("error", err))
+		return nil, text
+	}
+
+	logger.Get().Warn("总结 XML 解析失败，要求大模型重新生成", slog.Any("error", err))
+	retry := append(slices.Clone(messages),
+		openai.Message{Role: "assistant", Content: text},
+		openai.Message{Role: "user", Content: fmt.Sprintf(ragAnswerReaskPrompt, err)},
+	)
+	llmResp, err := s.LLM.CreateChatCompletion(ctx, openai.TaskSummary, model, retry)
+	if err != nil || len(llmResp.Choices) == 0 {
+		logger.Get().Warn("重新生成总结失败，返回原始文本", slog.Any("error", err))
+		return nil, text
+	}
+	content := llmResp.Choices[0].Message.Content
+	resp, err = prompts.ParseRAGResponse(content)
+	if err != nil {
+		logger.Get().Warn("重新生成的总结仍无法解析，返回原始文本", slog.Any("error", err))
+		return nil, text
+	}
+	answer := toRagAnswer(resp)
+	answer.Repaired = true
+	return answer, content
+}
+
+// toRagAnswer converts a parsed rag_response document into its API
+// representation.
+func toRagAnswer(resp *prompts.RAGResponse) *ragv1.RagAnswer {
+	points := func(in []prompts.RAGPoint) []*ragv1.AnswerPoint {
+		out := make([]*ragv1.AnswerPoint, 0, len(in))
+		for _, p := range in {
+			out = append(out, &ragv1.AnswerPoint{
+				Title:   strings.TrimSpace(p.Title),
+				Content: strings.TrimSpace(p.Content),
+			})
+		}
+		return out
+	}
+
+	keyPoints := make([]string, 0, len(resp.KeyPoints))
+	for _, point := range resp.KeyPoints {
+		if point = strings.TrimSpace(point); point != "" {
+			keyPoints = append(keyPoints, point)
+		}
+	}
+
+	sources := make([]*ragv1.AnswerSource, 0, len(resp.Sources))
+	for _, src := range resp.Sources {
+		sources = append(sources, &ragv1.AnswerSource{
+			Id:         strings.TrimSpace(src.ID),
+			Similarity: src.Score(),
+			Summary:    strings.TrimSpace(src.Summary),
+		})
+	}
+
+	return &ragv1.RagAnswer{
+		Summary:    resp.SummaryText(),
+		InfoPoints: points(resp.InfoPoints),
+		Sections:   points(resp.Sections),
+		KeyPoints:  keyPoints,
+		Completeness: &ragv1.AnswerCompleteness{
+			Assessment:  strings.TrimSpace(resp.Completeness.Assessment),
+			MissingInfo: strings.TrimSpace(resp.Completeness.MissingInfo),
+		},
+		Sources:  sources,
+		Repaired: resp.Repaired,
+	}
+}
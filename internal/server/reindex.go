@@ -0,0 +1,650 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hsn0918/rag/internal/adapters"
+	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
+	pkgembedding "github.com/hsn0918/rag/pkg/clients/embedding"
+	"github.com/hsn0918/rag/pkg/config"
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/hsn0918/rag/pkg/parsers"
+	"go.uber.org/fx"
+)
+
+// errTableSetMoved reports that the active table set is no longer the one a
+// job copied from, so its target cannot take over.
+var errTableSetMoved = errors.New("active table set changed since the job started")
+
+const (
+	// reindexBatchSize is how many missing documents the runner fetches at a
+	// time; the list is re-read after each batch so uploads made during the
+	// run are picked up too.
+	reindexBatchSize = 20
+	// maxCutoverAttempts bounds how often a cutover catches up with uploads
+	// that landed between the last copy and taking the lock.
+	maxCutoverAttempts = 3
+)
+
+var (
+	reindexStatusToProto = map[adapters.ReindexStatus]ragv1.ReindexJobStatus{
+		adapters.ReindexStatusRunning:    ragv1.ReindexJobStatus_REINDEX_JOB_STATUS_RUNNING,
+		adapters.ReindexStatusReady:      ragv1.ReindexJobStatus_REINDEX_JOB_STATUS_READY,
+		adapters.ReindexStatusActive:     ragv1.ReindexJobStatus_REINDEX_JOB_STATUS_ACTIVE,
+		adapters.ReindexStatusRolledBack: ragv1.ReindexJobStatus_REINDEX_JOB_STATUS_ROLLED_BACK,
+		adapters.ReindexStatusFailed:     ragv1.ReindexJobStatus_REINDEX_JOB_STATUS_FAILED,
+	}
+	reindexModeToProto = map[adapters.ReindexMode]ragv1.ReindexMode{
+		adapters.ReindexModeReembed: ragv1.ReindexMode_REINDEX_MODE_REEMBED,
+		adapters.ReindexModeRechunk: ragv1.ReindexMode_REINDEX_MODE_RECHUNK,
+	}
+)
+
+// Reindexer migrates every document of the active table set into a new
+// table set built with another embedding model. Documents keep their IDs;
+// the new set only serves queries after a cutover, which can be rolled back
+// to the previous set.
+type Reindexer struct {
+	server *RagServer
+	sets   adapters.TableSetStore
+	jobs   adapters.ReindexJobStore
+	cfg    config.IngestionConfig
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewReindexer creates the re-indexer; call Start before submitting jobs.
+func NewReindexer(server *RagServer, sets adapters.TableSetStore, jobs adapters.ReindexJobStore, cfg config.IngestionConfig) *Reindexer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Reindexer{server: server, sets: sets, jobs: jobs, cfg: cfg, ctx: ctx, cancel: cancel}
+}
+
+// Start fails jobs abandoned by a previous process and starts watching for
+// cutovers made by other instances.
+func (r *Reindexer) Start(ctx context.Context) error {
+	if _, err := r.jobs.FailStaleReindexJobs(ctx, r.cfg.StaleAfter); err != nil {
+		return err
+	}
+	r.wg.Go(func() {
+		r.watch(r.ctx)
+	})
+	return nil
+}
+
+// Stop cancels a running job, which is marked failed, and waits for it to
+// exit or ctx to expire.
+func (r *Reindexer) Stop(ctx context.Context) error {
+	r.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// watch periodically reloads the active table set so a cutover or rollback
+// on one instance reaches the others, and fails jobs whose instance died.
+func (r *Reindexer) watch(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+	reap := time.NewTicker(r.cfg.StaleAfter / 2)
+	defer reap.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.sets.RefreshActiveTableSet(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				logger.Get().Error("刷新生效表集失败", slog.Any("error", err))
+			}
+		case <-reap.C:
+			if _, err := r.jobs.FailStaleReindexJobs(ctx, r.cfg.StaleAfter); err != nil && !errors.Is(err, context.Canceled) {
+				logger.Get().Error("回收停滞重建任务失败", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// Submit creates a job and its empty target table set, then copies the
+// documents in the background.
+func (r *Reindexer) Submit(ctx context.Context, mode adapters.ReindexMode, model string, dimensions int, autoCutover bool) (*adapters.ReindexJob, error) {
+	job := &adapters.ReindexJob{
+		Mode:        mode,
+		Model:       model,
+		Dimensions:  dimensions,
+		SourceSet:   r.sets.ActiveTableSet().Name,
+		AutoCutover: autoCutover,
+	}
+	if err := r.jobs.CreateReindexJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	target, err := r.sets.CreateTableSet(ctx, model, dimensions)
+	if err != nil {
+		r.finish(ctx, job, err)
+		return nil, err
+	}
+	job.TargetSet = target.Name
+	r.save(ctx, job)
+
+	logger.Get().Info("重建索引任务已启动",
+		slog.String("job_id", job.ID),
+		slog.String("mode", string(mode)),
+		slog.String("model", model),
+		slog.String("source", job.SourceSet),
+		slog.String("target", job.TargetSet),
+	)
+	r.wg.Go(func() {
+		r.run(r.ctx, job)
+	})
+	return job, nil
+}
+
+func (r *Reindexer) run(ctx context.Context, job *adapters.ReindexJob) {
+	start := time.Now()
+	err := r.copyDocuments(ctx, job)
+	if err == nil && job.AutoCutover {
+		err = r.cutover(ctx, job)
+	}
+	// The final state must be written even when ctx was cancelled by shutdown.
+	r.finish(context.WithoutCancel(ctx), job, err)
+	logger.Get().Info("重建索引任务结束",
+		slog.String("job_id", job.ID),
+		slog.String("status", string(job.Status)),
+		slog.Int("documents", job.ProcessedDocuments),
+		slog.Int("chunks", job.ProcessedChunks),
+		slog.Duration("duration", time.Since(start)),
+	)
+}
+
+// finish records the job's outcome. A failed job's target table set is
+// dropped unless it already serves queries.
+func (r *Reindexer) finish(ctx context.Context, job *adapters.ReindexJob, err error) {
+	now := time.Now()
+	job.FinishedAt = &now
+	switch {
+	case err != nil:
+		job.Status = adapters.ReindexStatusFailed
+		job.Error = err.Error()
+		logger.Get().Error("重建索引任务失败", slog.String("job_id", job.ID), slog.Any("error", err))
+		if job.TargetSet != "" && r.sets.ActiveTableSet().Name != job.TargetSet {
+			if err := r.sets.DeleteTableSet(ctx, job.TargetSet); err != nil && !errors.Is(err, adapters.ErrTableSetNotFound) {
+				logger.Get().Warn("删除重建任务的表集失败", slog.String("table_set", job.TargetSet), slog.Any("error", err))
+			}
+		}
+	case job.Status == adapters.ReindexStatusRunning:
+		job.Status = adapters.ReindexStatusReady
+	}
+	r.save(ctx, job)
+}
+
+func (r *Reindexer) save(ctx context.Context, job *adapters.ReindexJob) {
+	if err := r.jobs.UpdateReindexJob(ctx, job); err != nil {
+		logger.Get().Warn("更新重建任务进度失败", slog.String("job_id", job.ID), slog.Any("error", err))
+	}
+}
+
+// copyDocuments writes every document missing from the target set, until
+// none is left, saving the job's progress after each document.
+func (r *Reindexer) copyDocuments(ctx context.Context, job *adapters.ReindexJob) error {
+	return r.copyMissing(ctx, job, func() { r.save(ctx, job) })
+}
+
+// copyMissing copies the documents of job's source set that its target set
+// lacks, re-embedding them for job's model. onCopy, when non-nil, is called
+// after each document.
+func (r *Reindexer) copyMissing(ctx context.Context, job *adapters.ReindexJob, onCopy func()) error {
+	source, err := r.sets.GetTableSet(ctx, job.SourceSet)
+	if err != nil {
+		return err
+	}
+	target, err := r.sets.GetTableSet(ctx, job.TargetSet)
+	if err != nil {
+		return err
+	}
+
+	for {
+		total, err := r.sets.CountDocuments(ctx, *source)
+		if err != nil {
+			return err
+		}
+		job.TotalDocuments = int(total)
+
+		docs, err := r.sets.ListDocumentsMissingFrom(ctx, *source, *target, reindexBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(docs) == 0 {
+			return nil
+		}
+
+		for _, doc := range docs {
+			chunks, err := r.server.reindexChunks(ctx, job, *source, doc)
+			if err != nil {
+				return fmt.Errorf("document %s: %w", doc.ID, err)
+			}
+			copied, err := r.sets.CopyDocument(ctx, *source, *target, doc.ID, chunks)
+			if err != nil {
+				return fmt.Errorf("document %s: %w", doc.ID, err)
+			}
+			// A document deleted meanwhile is simply not copied.
+			if copied {
+				job.ProcessedDocuments++
+				job.ProcessedChunks += len(chunks)
+			}
+			if onCopy != nil {
+				onCopy()
+			}
+		}
+	}
+}
+
+// cutover activates the job's target set.
+func (r *Reindexer) cutover(ctx context.Context, job *adapters.ReindexJob) error {
+	if active := r.sets.ActiveTableSet().Name; active != job.SourceSet {
+		return fmt.Errorf("%w: active table set is %s, job was built from %s", errTableSetMoved, active, job.SourceSet)
+	}
+	if err := r.activate(ctx, job, func() error { return r.copyDocuments(ctx, job) }); err != nil {
+		return err
+	}
+	job.Status = adapters.ReindexStatusActive
+	return nil
+}
+
+// rollback reactivates the job's source set. Documents uploaded since the
+// cutover exist only in the target set, so they are first copied back and
+// embedded with the source set's model; deletions carry over on activation.
+func (r *Reindexer) rollback(ctx context.Context, job *adapters.ReindexJob) error {
+	if active := r.sets.ActiveTableSet().Name; active != job.TargetSet {
+		return fmt.Errorf("%w: active table set is %s, job cut over to %s", errTableSetMoved, active, job.TargetSet)
+	}
+	source, err := r.sets.GetTableSet(ctx, job.SourceSet)
+	if err != nil {
+		return err
+	}
+
+	// The copy back runs the job in reverse; its progress is not recorded.
+	back := &adapters.ReindexJob{
+		ID:         job.ID,
+		Mode:       adapters.ReindexModeReembed,
+		Model:      source.Model,
+		Dimensions: source.Dimensions,
+		SourceSet:  job.TargetSet,
+		TargetSet:  job.SourceSet,
+	}
+	copyBack := func() error { return r.copyMissing(ctx, back, nil) }
+	if err := copyBack(); err != nil {
+		return err
+	}
+	if err := r.activate(ctx, back, copyBack); err != nil {
+		return err
+	}
+	if back.ProcessedDocuments > 0 {
+		logger.Get().Info("回滚时已补齐切换后上传的文档",
+			slog.String("job_id", job.ID),
+			slog.Int("documents", back.ProcessedDocuments),
+		)
+	}
+	job.Status = adapters.ReindexStatusRolledBack
+	return nil
+}
+
+// activate makes job's target set the active one. Uploads that land after
+// the last copy make the activation fail with ErrTableSetOutOfSync; they
+// are copied with copyDocuments and the activation retried.
+func (r *Reindexer) activate(ctx context.Context, job *adapters.ReindexJob, copyDocuments func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := r.sets.ActivateTableSet(ctx, job.TargetSet, true)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, adapters.ErrTableSetOutOfSync) || attempt == maxCutoverAttempts {
+			return err
+		}
+		logger.Get().Info("切换前补齐新增文档", slog.String("job_id", job.ID), slog.Int("attempt", attempt))
+		if err := copyDocuments(); err != nil {
+			return err
+		}
+	}
+}
+
+// reindexChunks produces doc's chunks for the job's model. Re-embedding
+// keeps the stored chunk texts; re-chunking splits the document's text again
+// and falls back to the stored chunks when the text is unavailable.
+//
+// Unlike ingestion, a reindex never stores a partially embedded document,
+// even with Ingestion.AllowPartial: any failed chunk fails the document and
+// with it the job, so a cutover cannot drop chunks silently.
+func (s *RagServer) reindexChunks(ctx context.Context, job *adapters.ReindexJob, source adapters.TableSet, doc adapters.DocumentRecord) ([]adapters.ChunkRecord, error) {
+	if job.Mode == adapters.ReindexModeRechunk {
+		chunks, err := s.rechunkDocument(ctx, job, doc)
+		if err == nil {
+			return chunks, nil
+		}
+		logger.Get().Warn("重新分块失败，沿用现有分块",
+			slog.String("document_id", doc.ID),
+			slog.Any("error", err),
+		)
+	}
+
+	chunks, err := s.TableSets.GetDocumentChunks(ctx, source, doc.ID)
+	if err != nil {
+		return nil, err
+	}
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = chunk.Content
+	}
+	embeddings, err := s.generateEmbeddings(ctx, job.Model, pkgembedding.RequestDimensions(job.Model, job.Dimensions), texts, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate embeddings: %w", err)
+	}
+
+	for i := range chunks {
+		chunks[i].Embedding = embeddings[i]
+	}
+	return chunks, nil
+}
+
+// rechunkDocument splits doc's text with the current chunking config. The
+// text comes from the processed-text cache written during ingestion, or from
+// parsing the original file when the cache is missing.
+func (s *RagServer) rechunkDocument(ctx context.Context, job *adapters.ReindexJob, doc adapters.DocumentRecord) ([]adapters.ChunkRecord, error) {
+	model, dimensions := job.Model, pkgembedding.RequestDimensions(job.Model, job.Dimensions)
+	text, err := s.documentText(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// The collection's chunking overrides apply; a collection deleted
+	// meanwhile takes its documents with it, so the copy is skipped anyway.
+	var collection *adapters.Collection
+	if doc.CollectionID != "" {
+		collection, err = s.DB.GetCollection(ctx, doc.CollectionID)
+		if err != nil && !errors.Is(err, adapters.ErrCollectionNotFound) {
+			return nil, err
+		}
+	}
+
+	chunks, err := s.chunkTextContent(ctx, s.cleanEmptyLines(text), s.chunkingConfig(collection), model, dimensions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to chunk text: %w", err)
+	}
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = s.cleanText(chunk.Content)
+	}
+	embeddings, err := s.generateEmbeddings(ctx, model, dimensions, texts, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate embeddings: %w", err)
+	}
+	return chunkRecords(chunks, texts, embeddings), nil
+}
+
+// documentText returns doc's extracted text.
+func (s *RagServer) documentText(ctx context.Context, doc adapters.DocumentRecord) (string, error) {
+	if md5Hash, _ := doc.Metadata["md5_hash"].(string); md5Hash != "" {
+		key := fmt.Sprintf("processed/%s.txt", md5Hash)
+		if exists, err := s.Storage.CheckFileExists(ctx, key); err == nil && exists {
+			data, err := s.downloadObject(ctx, key)
+			if err != nil {
+				return "", err
+			}
+			return string(data), nil
+		}
+	}
+
+	contentType, _ := doc.Metadata["content_type"].(string)
+	parser, err := s.Parsers.Lookup(doc.Title, contentType)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", err, doc.Title)
+	}
+	data, err := s.downloadObject(ctx, doc.MinioKey)
+	if err != nil {
+		return "", err
+	}
+	parsed, err := parser.Parse(ctx, parsers.Input{Filename: doc.Title, ContentType: contentType, Data: data})
+	if err != nil {
+		return "", fmt.Errorf("failed to parse document with %s parser: %w", parser.Name(), err)
+	}
+	if parsed.Content == "" {
+		return "", fmt.Errorf("no text extracted from document")
+	}
+	return parsed.Content, nil
+}
+
+func (s *RagServer) downloadObject(ctx context.Context, key string) ([]byte, error) {
+	object, err := s.Storage.DownloadFile(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", key, err)
+	}
+	defer object.Close()
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	return data, nil
+}
+
+// StartReindex 启动重建索引任务。未指定维度时，配置的模型使用
+// services.embedding.dimensions，其他模型使用原生维度；实际维度通过试算一次向量确认。
+func (s *RagServer) StartReindex(
+	ctx context.Context,
+	req *connect.Request[ragv1.StartReindexRequest],
+) (*connect.Response[ragv1.StartReindexResponse], error) {
+	model := strings.TrimSpace(req.Msg.GetEmbeddingModel())
+	if model == "" {
+		model = s.Config.Services.Embedding.Model
+	}
+	mode := adapters.ReindexModeReembed
+	if req.Msg.GetMode() == ragv1.ReindexMode_REINDEX_MODE_RECHUNK {
+		mode = adapters.ReindexModeRechunk
+	}
+	dimensions := int(req.Msg.GetDimensions())
+	if dimensions == 0 && model == s.Config.Services.Embedding.Model {
+		dimensions = s.Config.Services.Embedding.Dimensions
+	}
+	if dimensions > 0 {
+		if _, err := pkgembedding.ResolveDimensions(model, dimensions); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	vec, err := s.generateEmbedding(ctx, model, pkgembedding.RequestDimensions(model, dimensions), model)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("embedding model %q is unusable: %w", model, err))
+	}
+	if dimensions > 0 && len(vec) != dimensions {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("embedding model %q produces %d dimensions, expected %d", model, len(vec), dimensions))
+	}
+	if active := s.DB.ActiveTableSet(); mode == adapters.ReindexModeReembed && active.Model == model && active.Dimensions == len(vec) {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("table set %s already uses embedding model %q at %d dimensions", active.Name, model, len(vec)))
+	}
+
+	job, err := s.Reindex.Submit(ctx, mode, model, len(vec), req.Msg.GetAutoCutover())
+	if errors.Is(err, adapters.ErrReindexRunning) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&ragv1.StartReindexResponse{Job: toProtoReindexJob(job)}), nil
+}
+
+// GetReindexJob 查询重建索引任务的状态与进度
+func (s *RagServer) GetReindexJob(
+	ctx context.Context,
+	req *connect.Request[ragv1.GetReindexJobRequest],
+) (*connect.Response[ragv1.GetReindexJobResponse], error) {
+	job, err := s.getReindexJob(ctx, req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ragv1.GetReindexJobResponse{Job: toProtoReindexJob(job)}), nil
+}
+
+// CutoverReindex 把已就绪任务的新表集切换为生效表集
+func (s *RagServer) CutoverReindex(
+	ctx context.Context,
+	req *connect.Request[ragv1.CutoverReindexRequest],
+) (*connect.Response[ragv1.CutoverReindexResponse], error) {
+	job, err := s.getReindexJob(ctx, req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != adapters.ReindexStatusReady {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("reindex job %s is %s, only ready jobs can be cut over", job.ID, job.Status))
+	}
+
+	if err := s.Reindex.cutover(ctx, job); err != nil {
+		return nil, tableSetError(err)
+	}
+	job.Error = ""
+	s.Reindex.save(ctx, job)
+	return connect.NewResponse(&ragv1.CutoverReindexResponse{Job: toProtoReindexJob(job)}), nil
+}
+
+// RollbackReindex 重新启用任务的原表集。切换后写入的文档先用原表集的模型
+// 复制回原表集，切换后的删除同样同步，回滚不丢失文档；新表集保留，可通过
+// DeleteTableSet 删除。
+func (s *RagServer) RollbackReindex(
+	ctx context.Context,
+	req *connect.Request[ragv1.RollbackReindexRequest],
+) (*connect.Response[ragv1.RollbackReindexResponse], error) {
+	job, err := s.getReindexJob(ctx, req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != adapters.ReindexStatusActive {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("reindex job %s is %s, only active jobs can be rolled back", job.ID, job.Status))
+	}
+
+	if err := s.Reindex.rollback(ctx, job); err != nil {
+		return nil, tableSetError(err)
+	}
+	s.Reindex.save(ctx, job)
+	return connect.NewResponse(&ragv1.RollbackReindexResponse{Job: toProtoReindexJob(job)}), nil
+}
+
+// ListTableSets 列出全部表集
+func (s *RagServer) ListTableSets(
+	ctx context.Context,
+	_ *connect.Request[ragv1.ListTableSetsRequest],
+) (*connect.Response[ragv1.ListTableSetsResponse], error) {
+	sets, err := s.TableSets.ListTableSets(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	out := make([]*ragv1.TableSet, 0, len(sets))
+	for _, set := range sets {
+		protoSet := &ragv1.TableSet{
+			Name:           set.Name,
+			EmbeddingModel: set.Model,
+			Dimensions:     int32(set.Dimensions),
+			Active:         set.Active,
+			CreatedAt:      set.CreatedAt.UTC().Format(time.RFC3339),
+		}
+		if set.ActivatedAt != nil {
+			protoSet.ActivatedAt = set.ActivatedAt.UTC().Format(time.RFC3339)
+		}
+		out = append(out, protoSet)
+	}
+	return connect.NewResponse(&ragv1.ListTableSetsResponse{TableSets: out}), nil
+}
+
+// DeleteTableSet 删除未生效的表集及其全部数据
+func (s *RagServer) DeleteTableSet(
+	ctx context.Context,
+	req *connect.Request[ragv1.DeleteTableSetRequest],
+) (*connect.Response[ragv1.DeleteTableSetResponse], error) {
+	if err := s.TableSets.DeleteTableSet(ctx, req.Msg.GetName()); err != nil {
+		return nil, tableSetError(err)
+	}
+	logger.Get().Info("表集已删除", "table_set", req.Msg.GetName())
+
+	return connect.NewResponse(&ragv1.DeleteTableSetResponse{
+		Success: true,
+		Message: "table set deleted",
+	}), nil
+}
+
+func (s *RagServer) getReindexJob(ctx context.Context, id string) (*adapters.ReindexJob, error) {
+	job, err := s.Reindex.jobs.GetReindexJob(ctx, id)
+	if errors.Is(err, adapters.ErrReindexJobNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return job, nil
+}
+
+// tableSetError maps table set errors to connect codes.
+func tableSetError(err error) error {
+	switch {
+	case errors.Is(err, adapters.ErrTableSetNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, adapters.ErrTableSetActive), errors.Is(err, adapters.ErrTableSetOutOfSync),
+		errors.Is(err, errTableSetMoved):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func toProtoReindexJob(job *adapters.ReindexJob) *ragv1.ReindexJob {
+	out := &ragv1.ReindexJob{
+		Id:                 job.ID,
+		Status:             reindexStatusToProto[job.Status],
+		Mode:               reindexModeToProto[job.Mode],
+		EmbeddingModel:     job.Model,
+		Dimensions:         int32(job.Dimensions),
+		SourceTableSet:     job.SourceSet,
+		TargetTableSet:     job.TargetSet,
+		AutoCutover:        job.AutoCutover,
+		TotalDocuments:     int32(job.TotalDocuments),
+		ProcessedDocuments: int32(job.ProcessedDocuments),
+		ProcessedChunks:    int32(job.ProcessedChunks),
+		Error:              job.Error,
+		CreatedAt:          job.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:          job.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if job.FinishedAt != nil {
+		out.FinishedAt = job.FinishedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+// StartReindexer 启动重建索引任务的后台协程
+func StartReindexer(server *RagServer, lifecycle fx.Lifecycle) {
+	lifecycle.Append(fx.Hook{
+		OnStart: server.Reindex.Start,
+		OnStop: func(ctx context.Context) error {
+			logger.Get().Info("停止重建索引任务")
+			return server.Reindex.Stop(ctx)
+		},
+	})
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"sort"
 	"strings"
 	"sync"
 
 	"github.com/hsn0918/rag/internal/adapters"
+	pkgrerank "github.com/hsn0918/rag/pkg/clients/rerank"
 	"github.com/hsn0918/rag/pkg/logger"
 )
 
@@ -47,12 +49,27 @@ type Config struct {
 	PhraseWeight  float64
 	QualityWeight float64
 
-	// Search parameters.
+	// MinSimilarity is the minimum cosine similarity of vector candidates.
+	// It applies before fusion only; fused scores are never compared to it.
 	MinSimilarity float64
 
+	// Fusion merges the vector and keyword result lists.
+	Fusion FusionStrategy
+
+	// Filter restricts both searches; the zero value searches everything.
+	Filter adapters.SearchFilter
+
+	// ANN overrides the vector index query parameters; zero fields use
+	// the server configuration.
+	ANN adapters.ANNParams
+
 	// Performance optimization.
 	EnableParallelScoring bool
 	CacheSearchResults    bool
+
+	// Optional cross-encoder rerank stage.
+	Reranker    pkgrerank.Reranker
+	RerankModel string
 }
 
 // Option is a functional option for configuring SearchOptimizer.
@@ -79,15 +96,29 @@ func WithKeywordWeight(weight float64) Option {
 	}
 }
 
-// WithMinSimilarity sets the minimum similarity threshold for filtering results.
+// WithMinSimilarity sets the minimum cosine similarity for vector results.
 //
-// Results with similarity scores below this threshold will be excluded.
+// The threshold is applied by the vector search, before fusion. Keyword
+// results and fused or hybrid scores are not filtered by it.
 func WithMinSimilarity(threshold float64) Option {
 	return func(c *Config) {
 		c.MinSimilarity = threshold
 	}
 }
 
+// WithFinalResults sets how many results are returned after reranking.
+//
+// InitialCandidates is raised to the same value when it would otherwise be
+// smaller, so the candidate pool always covers the requested result count.
+func WithFinalResults(n int) Option {
+	return func(c *Config) {
+		c.FinalResults = n
+		if c.InitialCandidates < n {
+			c.InitialCandidates = n
+		}
+	}
+}
+
 // WithParallelScoring enables or disables parallel scoring of search results.
 //
 // When enabled, scoring operations will be performed concurrently for better
@@ -98,6 +129,42 @@ func WithParallelScoring(enabled bool) Option {
 	}
 }
 
+// WithFusion sets how vector and keyword results are merged before hybrid
+// scoring. The default is Reciprocal Rank Fusion with k = DefaultRRFK.
+func WithFusion(strategy FusionStrategy) Option {
+	return func(c *Config) {
+		c.Fusion = strategy
+	}
+}
+
+// WithFilter restricts the vector and keyword searches to chunks matching
+// filter. The filter is applied inside the database queries, so it narrows
+// the candidate pool rather than the final results.
+func WithFilter(filter adapters.SearchFilter) Option {
+	return func(c *Config) {
+		c.Filter = filter
+	}
+}
+
+// WithANNParams overrides the vector index query parameters, trading
+// latency for recall on a single search.
+func WithANNParams(params adapters.ANNParams) Option {
+	return func(c *Config) {
+		c.ANN = params
+	}
+}
+
+// WithReranker enables reranking of the hybrid-scored candidates with a
+// cross-encoder model. The model's relevance score then orders the final
+// results in place of the keyword heuristic; when the service fails the
+// optimizer falls back to hybrid ranking.
+func WithReranker(reranker pkgrerank.Reranker, model string) Option {
+	return func(c *Config) {
+		c.Reranker = reranker
+		c.RerankModel = model
+	}
+}
+
 // NewSearchOptimizer creates a new SearchOptimizer with the specified configuration.
 //
 // The initialCandidates parameter determines how many results to fetch initially,
@@ -127,6 +194,7 @@ func NewSearchOptimizer(
 		PhraseWeight:          0.2,
 		QualityWeight:         0.1,
 		MinSimilarity:         0.25,
+		Fusion:                RRFFusion{K: DefaultRRFK},
 		EnableParallelScoring: true,
 	}
 
@@ -149,18 +217,32 @@ func NewSearchOptimizer(
 // This method:
 //  1. Extracts search components from the query
 //  2. Performs parallel vector and keyword searches
-//  3. Merges and deduplicates results
+//  3. Fuses the two result lists with the configured strategy
 //  4. Applies hybrid scoring
-//  5. Reranks and filters results
+//  5. Reranks and filters results, with the rerank model when configured
+//
+// Options passed here override the optimizer configuration for this call
+// only, which lets a request tune result count or similarity threshold.
 //
 // Returns the final ranked results or an error if the search fails.
-func (so *SearchOptimizer) OptimizedSearch(ctx context.Context, query string, queryVector []float32) ([]adapters.ChunkSearchResult, error) {
+func (so *SearchOptimizer) OptimizedSearch(ctx context.Context, query string, queryVector []float32, opts ...Option) ([]adapters.ChunkSearchResult, error) {
 	if query == "" || len(queryVector) == 0 {
 		return nil, fmt.Errorf("%w: query and vector are required", ErrInvalidOptConfig)
 	}
+	if len(opts) > 0 {
+		cfg := so.cfg
+		for _, opt := range opts {
+			opt(&cfg)
+		}
+		if err := cfg.validate(); err != nil {
+			return nil, err
+		}
+		so = &SearchOptimizer{ragServer: so.ragServer, cfg: cfg}
+	}
 	logger.Get().Info("Starting optimized search",
 		"query", query,
 		"vector_dim", len(queryVector),
+		"filtered", !so.cfg.Filter.IsZero(),
 	)
 
 	// Extract search components.
@@ -172,7 +254,7 @@ func (so *SearchOptimizer) OptimizedSearch(ctx context.Context, query string, qu
 	var wg sync.WaitGroup
 	wg.Go(func() {
 		vectorResults, vectorErr = so.ragServer.DB.SearchSimilarChunks(
-			ctx, queryVector, so.cfg.InitialCandidates, float32(so.cfg.MinSimilarity),
+			ctx, queryVector, so.cfg.InitialCandidates, float32(so.cfg.MinSimilarity), so.cfg.Filter, so.cfg.ANN,
 		)
 	})
 	wg.Go(func() {
@@ -189,7 +271,7 @@ func (so *SearchOptimizer) OptimizedSearch(ctx context.Context, query string, qu
 	}
 
 	// Merge and deduplicate results.
-	merged := so.mergeResults(vectorResults, keywordResults)
+	merged := so.cfg.Fusion.Fuse(vectorResults, keywordResults)
 	if len(merged) == 0 {
 		return nil, ErrNoSearchResults
 	}
@@ -198,12 +280,24 @@ func (so *SearchOptimizer) OptimizedSearch(ctx context.Context, query string, qu
 	scored := so.applyHybridScoring(merged, components)
 
 	// Re-rank and filter.
-	finalResults := so.rerankAndFilter(scored)
+	var finalResults []adapters.ChunkSearchResult
+	if so.cfg.Reranker != nil {
+		reranked, err := so.rerankWithModel(ctx, query, scored)
+		if err != nil {
+			logger.Get().Warn("Rerank model failed, falling back to hybrid ranking", "error", err)
+		} else {
+			finalResults = reranked
+		}
+	}
+	if finalResults == nil {
+		finalResults = so.rerankAndFilter(scored)
+	}
 
 	logger.Get().Info("Optimized search completed",
 		"vector_results", len(vectorResults),
 		"keyword_results", len(keywordResults),
 		"final_results", len(finalResults),
+		"model_reranked", isModelReranked(finalResults),
 	)
 
 	return finalResults, nil
@@ -269,46 +363,21 @@ func (so *SearchOptimizer) extractSearchComponents(query string) searchComponent
 	return components
 }
 
-// performKeywordSearch performs keyword-based search.
+// performKeywordSearch performs full-text search over chunk content.
 //
-// This is a placeholder implementation. In a production system, this would
-// query the database using keyword matching algorithms.
-func (so *SearchOptimizer) performKeywordSearch(_ context.Context, _ []string) []adapters.ChunkSearchResult {
-	// Placeholder - actual implementation would query the database.
-	return []adapters.ChunkSearchResult{}
-}
-
-// mergeResults combines and deduplicates results from different search methods.
-//
-// When a chunk appears in multiple result sets, their scores are averaged
-// to create a combined relevance score.
-func (so *SearchOptimizer) mergeResults(vectorResults, keywordResults []adapters.ChunkSearchResult) []adapters.ChunkSearchResult {
-	resultMap := make(map[string]*adapters.ChunkSearchResult)
-
-	// Add vector results.
-	for i := range vectorResults {
-		result := &vectorResults[i]
-		resultMap[result.ChunkID] = result
-	}
-
-	// Merge keyword results.
-	for i := range keywordResults {
-		result := &keywordResults[i]
-		if existing, exists := resultMap[result.ChunkID]; exists {
-			// Combine scores if chunk appears in both results.
-			existing.Similarity = (existing.Similarity + result.Similarity) / 2
-		} else {
-			resultMap[result.ChunkID] = result
-		}
-	}
-
-	// Convert map back to slice.
-	var merged []adapters.ChunkSearchResult
-	for _, result := range resultMap {
-		merged = append(merged, *result)
+// It uses the chinese_zh text search index, matching any of the keywords
+// and scoring by ts_rank_cd. Failures are logged and yield no results, so
+// the search degrades to vector-only rather than failing.
+func (so *SearchOptimizer) performKeywordSearch(ctx context.Context, keywords []string) []adapters.ChunkSearchResult {
+	results, err := so.ragServer.DB.SearchByKeywords(ctx, keywords, so.cfg.InitialCandidates, so.cfg.Filter)
+	if err != nil {
+		logger.Get().Warn("Keyword search failed, continuing with vector results only",
+			"keywords", keywords,
+			"error", err,
+		)
+		return nil
 	}
-
-	return merged
+	return results
 }
 
 // applyHybridScoring calculates hybrid scores for all results.
@@ -348,7 +417,7 @@ func (so *SearchOptimizer) parallelHybridScoring(results []adapters.ChunkSearchR
 // calculateHybridScore computes the hybrid score for a single result.
 //
 // The score combines multiple signals according to the configured weights:
-//   - Vector similarity: Semantic relevance
+//   - Retrieval score: Fused vector and full-text relevance
 //   - Keyword matching: Term presence
 //   - Phrase matching: Exact phrase matches
 //   - Content quality: Length and structure metrics
@@ -466,8 +535,9 @@ func (so *SearchOptimizer) calculateQualityScore(result *adapters.ChunkSearchRes
 
 // rerankAndFilter performs final re-ranking and filtering of results.
 //
-// This method sorts results by hybrid score, applies minimum score
-// thresholds, limits the result count, and ensures diversity.
+// This method sorts results by hybrid score, limits the result count, and
+// ensures diversity. Candidates were already thresholded on vector
+// similarity before fusion, so the hybrid score is only used for ordering.
 func (so *SearchOptimizer) rerankAndFilter(results []adapters.ChunkSearchResult) []adapters.ChunkSearchResult {
 	// Sort by hybrid score.
 	sort.Slice(results, func(i, j int) bool {
@@ -476,28 +546,82 @@ func (so *SearchOptimizer) rerankAndFilter(results []adapters.ChunkSearchResult)
 		return scoreI > scoreJ
 	})
 
-	// Apply filters.
-	var filtered []adapters.ChunkSearchResult
-	for _, result := range results {
-		hybridScore, _ := result.Metadata["hybrid_score"].(float64)
+	// Limit to final result count.
+	filtered := results[:min(len(results), so.cfg.FinalResults)]
 
-		// Filter by minimum score threshold.
-		if hybridScore < so.cfg.MinSimilarity {
-			continue
-		}
+	// Diversity optimization: ensure results aren't too similar.
+	filtered = so.ensureDiversity(filtered)
 
-		filtered = append(filtered, result)
+	return filtered
+}
 
-		// Limit to final result count.
-		if len(filtered) >= so.cfg.FinalResults {
-			break
+// rerankWithModel orders the hybrid-scored candidates by the rerank
+// model's relevance score and keeps the top FinalResults. The score is
+// stored in Metadata["rerank_score"], which tells later stages the results
+// are already reranked.
+//
+// The response is validated before any result is touched, and scores go
+// into copies of the metadata, so on error the input results are unchanged
+// and can still be ranked by the hybrid fallback.
+func (so *SearchOptimizer) rerankWithModel(ctx context.Context, query string, results []adapters.ChunkSearchResult) ([]adapters.ChunkSearchResult, error) {
+	sort.Slice(results, func(i, j int) bool {
+		scoreI, _ := results[i].Metadata["hybrid_score"].(float64)
+		scoreJ, _ := results[j].Metadata["hybrid_score"].(float64)
+		return scoreI > scoreJ
+	})
+	candidates := results[:min(len(results), so.cfg.InitialCandidates)]
+
+	documents := make([]string, len(candidates))
+	for i, result := range candidates {
+		documents[i] = result.Content
+	}
+
+	resp, err := so.cfg.Reranker.Rerank(ctx, so.cfg.RerankModel, query, documents, so.cfg.FinalResults)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Results) == 0 {
+		return nil, fmt.Errorf("rerank returned no results for %d documents", len(documents))
+	}
+
+	seen := make(map[int]bool, len(resp.Results))
+	for _, r := range resp.Results {
+		if r.Index < 0 || r.Index >= len(candidates) {
+			return nil, fmt.Errorf("rerank result index %d out of range", r.Index)
 		}
+		if seen[r.Index] {
+			return nil, fmt.Errorf("rerank result index %d repeated", r.Index)
+		}
+		seen[r.Index] = true
 	}
 
-	// Diversity optimization: ensure results aren't too similar.
-	filtered = so.ensureDiversity(filtered)
+	reranked := make([]adapters.ChunkSearchResult, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		result := candidates[r.Index]
+		result.Metadata = maps.Clone(result.Metadata)
+		if result.Metadata == nil {
+			result.Metadata = make(map[string]interface{})
+		}
+		result.Metadata["rerank_score"] = r.RelevanceScore
+		reranked = append(reranked, result)
+	}
+	sort.SliceStable(reranked, func(i, j int) bool {
+		return reranked[i].Metadata["rerank_score"].(float64) > reranked[j].Metadata["rerank_score"].(float64)
+	})
+	if len(reranked) > so.cfg.FinalResults {
+		reranked = reranked[:so.cfg.FinalResults]
+	}
 
-	return filtered
+	return so.ensureDiversity(reranked), nil
+}
+
+// isModelReranked reports whether results were ordered by the rerank model.
+func isModelReranked(results []adapters.ChunkSearchResult) bool {
+	if len(results) == 0 {
+		return false
+	}
+	_, ok := results[0].Metadata["rerank_score"].(float64)
+	return ok
 }
 
 // ensureDiversity ensures diversity in the final results.
@@ -585,6 +709,12 @@ func (c *Config) validate() error {
 	if c.MinSimilarity < 0 || c.MinSimilarity > 1 {
 		return fmt.Errorf("%w: min similarity must be in [0,1]", ErrInvalidOptConfig)
 	}
+	if c.Fusion == nil {
+		return fmt.Errorf("%w: fusion strategy is required", ErrInvalidOptConfig)
+	}
+	if err := c.Filter.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidOptConfig, err)
+	}
 
 	return nil
 }
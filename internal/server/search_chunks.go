@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
+	"github.com/hsn0918/rag/pkg/logger"
+)
+
+// Search runs the retrieval half of the RAG pipeline and returns the ranked
+// chunks without asking the LLM for a summary.
+//
+// It shares the keyword, embedding, search and rerank stages with
+// GetContext. LLM keyword extraction only runs when the request asks for
+// it, so a search can complete without any LLM call.
+func (s *RagServer) Search(
+	ctx context.Context,
+	req *connect.Request[ragv1.SearchRequest],
+) (*connect.Response[ragv1.SearchResponse], error) {
+	startTime := time.Now()
+	query := req.Msg.GetQuery()
+
+	if query == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("query is required"))
+	}
+
+	logger.Get().Info("开始处理检索请求",
+		slog.String("query", query),
+		slog.Int("top_k", int(req.Msg.GetTopK())),
+		slog.Float64("min_similarity", float64(req.Msg.GetMinSimilarity())),
+		slog.Bool("use_llm_keywords", req.Msg.GetUseLlmKeywords()),
+		slog.String("request_id", req.Header().Get("X-Request-ID")),
+	)
+
+	filter, collection, err := s.queryScope(ctx, req.Msg.GetCollectionId(), req.Msg.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	stage := &contextStages{
+		query:         query,
+		topK:          int(req.Msg.GetTopK()),
+		minSimilarity: req.Msg.GetMinSimilarity(),
+		basicKeywords: !req.Msg.GetUseLlmKeywords(),
+		filter:        filter,
+		ann:           annParamsFromProto(req.Msg.GetAnn()),
+		collection:    collection,
+	}
+
+	if err := s.runKeywordStage(ctx, stage); err != nil {
+		return nil, err
+	}
+	if err := s.runEmbeddingStage(ctx, stage); err != nil {
+		return nil, err
+	}
+	if err := s.runSearchStage(ctx, stage); err != nil {
+		return nil, err
+	}
+	if len(stage.similarChunks) > 0 {
+		s.runRerankStage(ctx, stage)
+	}
+
+	logger.Get().Info("检索完成",
+		slog.String("query", stage.query),
+		slog.Int("chunks_found", len(stage.similarChunks)),
+		slog.Int("chunks_returned", len(stage.rankedChunks)),
+		slog.Duration("total_duration", time.Since(startTime)),
+	)
+
+	return connect.NewResponse(&ragv1.SearchResponse{
+		Chunks:   toRetrievedChunks(stage.rankedChunks),
+		Keywords: stage.keywords,
+	}), nil
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hsn0918/rag/internal/adapters"
+	pkgrerank "github.com/hsn0918/rag/pkg/clients/rerank"
+)
+
+// fakeReranker returns a fixed response, or err when set.
+type fakeReranker struct {
+	results []pkgrerank.Result
+	err     error
+}
+
+func (f fakeReranker) Rerank(_ context.Context, _, _ string, _ []string, _ int) (*pkgrerank.Response, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &pkgrerank.Response{Results: f.results}, nil
+}
+
+// hybridResults builds candidates with descending hybrid scores and
+// unrelated content, so neither sorting nor diversity filtering reorders
+// them.
+func hybridResults() []adapters.ChunkSearchResult {
+	contents := []string{"alpha beta", "gamma delta", "epsilon zeta"}
+	results := make([]adapters.ChunkSearchResult, len(contents))
+	for i, content := range contents {
+		results[i] = adapters.ChunkSearchResult{
+			ChunkID:  string(rune('a' + i)),
+			Content:  content,
+			Metadata: map[string]interface{}{"hybrid_score": float64(len(contents) - i)},
+		}
+	}
+	return results
+}
+
+func TestRerankWithModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []pkgrerank.Result
+		err     error
+		order   []string
+		wantErr bool
+	}{
+		{
+			name:    "ordered by relevance score",
+			results: []pkgrerank.Result{{Index: 1, RelevanceScore: 0.4}, {Index: 2, RelevanceScore: 0.9}, {Index: 0, RelevanceScore: 0.1}},
+			order:   []string{"c", "b"},
+		},
+		{
+			name:    "index out of range",
+			results: []pkgrerank.Result{{Index: 0, RelevanceScore: 0.9}, {Index: 3, RelevanceScore: 0.5}},
+			wantErr: true,
+		},
+		{
+			name:    "negative index",
+			results: []pkgrerank.Result{{Index: 1, RelevanceScore: 0.9}, {Index: -1, RelevanceScore: 0.5}},
+			wantErr: true,
+		},
+		{
+			name:    "repeated index",
+			results: []pkgrerank.Result{{Index: 1, RelevanceScore: 0.9}, {Index: 1, RelevanceScore: 0.8}},
+			wantErr: true,
+		},
+		{
+			name:    "no results",
+			wantErr: true,
+		},
+		{
+			name:    "service error",
+			err:     errors.New("rerank unavailable"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			so := &SearchOptimizer{cfg: Config{
+				InitialCandidates: 3,
+				FinalResults:      2,
+				Reranker:          fakeReranker{results: tt.results, err: tt.err},
+			}}
+			scored := hybridResults()
+
+			got, err := so.rerankWithModel(context.Background(), "query", scored)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("want an error")
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(got) != len(tt.order) {
+					t.Fatalf("got %d results, want %d", len(got), len(tt.order))
+				}
+				for i, id := range tt.order {
+					if got[i].ChunkID != id {
+						t.Errorf("result %d = %s, want %s", i, got[i].ChunkID, id)
+					}
+				}
+				if !isModelReranked(got) {
+					t.Error("reranked results are not marked as model reranked")
+				}
+			}
+
+			for _, r := range scored {
+				if _, ok := r.Metadata["rerank_score"]; ok {
+					t.Fatalf("input result %s was given a rerank_score", r.ChunkID)
+				}
+			}
+			if isModelReranked(so.rerankAndFilter(scored)) {
+				t.Error("hybrid fallback results are marked as model reranked")
+			}
+		})
+	}
+}
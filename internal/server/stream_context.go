@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/hsn0918/rag/internal/adapters"
+	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
+	pkgopenai "github.com/hsn0918/rag/pkg/clients/openai"
+	"github.com/hsn0918/rag/pkg/logger"
+)
+
+// contextEventSender pushes StreamContext events to the client, stamping
+// each one with the time elapsed since the request started.
+type contextEventSender struct {
+	stream    *connect.ServerStream[ragv1.StreamContextResponse]
+	startTime time.Time
+}
+
+func (e *contextEventSender) send(msg *ragv1.StreamContextResponse) error {
+	msg.ElapsedMs = time.Since(e.startTime).Milliseconds()
+	if err := e.stream.Send(msg); err != nil {
+		return fmt.Errorf("failed to send %s event: %w", msg.GetStage(), err)
+	}
+	return nil
+}
+
+// StreamContext runs the same pipeline as GetContext but pushes an event to
+// the client as soon as each stage finishes, then streams the LLM summary
+// token by token.
+//
+// Events are emitted in order: keywords, embedding, search, rerank, a
+// sequence of summary deltas and finally a done event carrying the full
+// context. If the search finds nothing the stream ends right after the
+// search event with a done event holding the "no results" message.
+func (s *RagServer) StreamContext(
+	ctx context.Context,
+	req *connect.Request[ragv1.StreamContextRequest],
+	stream *connect.ServerStream[ragv1.StreamContextResponse],
+) error {
+	startTime := time.Now()
+	query := req.Msg.GetQuery()
+
+	if query == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("query is required"))
+	}
+
+	logger.Get().Info("开始处理流式文档检索请求",
+		slog.String("query", query),
+		slog.Int("query_length", len(query)),
+		slog.String("request_id", req.Header().Get("X-Request-ID")),
+		slog.Time("start_time", startTime),
+	)
+
+	if err := s.checkModel(req.Msg.GetModel()); err != nil {
+		return err
+	}
+	filter, collection, err := s.queryScope(ctx, req.Msg.GetCollectionId(), req.Msg.GetFilter())
+	if err != nil {
+		return err
+	}
+	events := &contextEventSender{stream: stream, startTime: startTime}
+	stage := &contextStages{
+		query:      query,
+		filter:     filter,
+		ann:        annParamsFromProto(req.Msg.GetAnn()),
+		model:      req.Msg.GetModel(),
+		collection: collection,
+	}
+
+	if err := s.runKeywordStage(ctx, stage); err != nil {
+		return err
+	}
+	if err := events.send(&ragv1.StreamContextResponse{
+		Stage: ragv1.ContextStage_CONTEXT_STAGE_KEYWORDS,
+		Event: &ragv1.StreamContextResponse_KeywordsReady{
+			KeywordsReady: &ragv1.KeywordsReady{Keywords: stage.keywords},
+		},
+	}); err != nil {
+		return err
+	}
+
+	if err := s.runEmbeddingStage(ctx, stage); err != nil {
+		return err
+	}
+	if err := events.send(&ragv1.StreamContextResponse{
+		Stage: ragv1.ContextStage_CONTEXT_STAGE_EMBEDDING,
+		Event: &ragv1.StreamContextResponse_EmbeddingReady{
+			EmbeddingReady: &ragv1.EmbeddingReady{Dimensions: int32(len(stage.queryVector))},
+		},
+	}); err != nil {
+		return err
+	}
+
+	if err := s.runSearchStage(ctx, stage); err != nil {
+		return err
+	}
+	if err := events.send(&ragv1.StreamContextResponse{
+		Stage: ragv1.ContextStage_CONTEXT_STAGE_SEARCH,
+		Event: &ragv1.StreamContextResponse_ChunksFound{
+			ChunksFound: &ragv1.ChunksFound{Count: int32(len(stage.similarChunks))},
+		},
+	}); err != nil {
+		return err
+	}
+
+	if len(stage.similarChunks) == 0 {
+		logger.Get().Warn("未找到相关文档",
+			slog.String("query", stage.query),
+			slog.Any("keywords", stage.keywords),
+			slog.Duration("total_duration", time.Since(startTime)),
+		)
+		return events.sendDone(noResultsMessage(stage.query), stage.keywords, nil, nil)
+	}
+
+	s.runRerankStage(ctx, stage)
+	if err := events.send(&ragv1.StreamContextResponse{
+		Stage: ragv1.ContextStage_CONTEXT_STAGE_RERANK,
+		Event: &ragv1.StreamContextResponse_ChunksReranked{
+			ChunksReranked: &ragv1.ChunksReranked{Count: int32(len(stage.rankedChunks))},
+		},
+	}); err != nil {
+		return err
+	}
+
+	summaryStart := time.Now()
+	contextContent, answer, err := s.streamContextSummary(ctx, stage.rankedChunks, stage.query, stage.systemPrompt(), stage.model, events.sendSummaryDelta)
+	if err != nil {
+		logger.Get().Error("流式总结生成失败",
+			slog.Any("error", err),
+			slog.Duration("failed_duration", time.Since(summaryStart)),
+		)
+		return err
+	}
+
+	logger.Get().Info("流式文档检索完成",
+		slog.String("query", stage.query),
+		slog.Int("chunks_found", len(stage.similarChunks)),
+		slog.Int("chunks_used", len(stage.rankedChunks)),
+		slog.Int("response_length", len(contextContent)),
+		slog.Duration("summary_duration", time.Since(summaryStart)),
+		slog.Duration("total_duration", time.Since(startTime)),
+	)
+
+	return events.sendDone(contextContent, stage.keywords, toRetrievedChunks(stage.rankedChunks), answer)
+}
+
+func (e *contextEventSender) sendSummaryDelta(content string) error {
+	return e.send(&ragv1.StreamContextResponse{
+		Stage: ragv1.ContextStage_CONTEXT_STAGE_SUMMARY,
+		Event: &ragv1.StreamContextResponse_SummaryDelta{
+			SummaryDelta: &ragv1.SummaryDelta{Content: content},
+		},
+	})
+}
+
+func (e *contextEventSender) sendDone(contextContent string, keywords []string, chunks []*ragv1.RetrievedChunk, answer *ragv1.RagAnswer) error {
+	return e.send(&ragv1.StreamContextResponse{
+		Stage: ragv1.ContextStage_CONTEXT_STAGE_COMPLETE,
+		Event: &ragv1.StreamContextResponse_Done{
+			Done: &ragv1.ContextDone{Context: contextContent, Keywords: keywords, Chunks: chunks, RagAnswer: answer},
+		},
+	})
+}
+
+// streamContextSummary streams the LLM summary for chunks through emit and
+// returns the complete text and its parsed RagAnswer. The text differs from
+// what was emitted when parseRagAnswer had the LLM re-emit a malformed
+// document.
+//
+// When the LLM is unavailable or fails before producing any token, the basic
+// template summary is emitted as a single delta instead, matching the
+// fallback behaviour of generateContextSummary. Errors after the first token
+// are returned because the client has already received partial output.
+func (s *RagServer) streamContextSummary(
+	ctx context.Context,
+	chunks []adapters.ChunkSearchResult,
+	query, systemPrompt, model string,
+	emit func(content string) error,
+) (string, *ragv1.RagAnswer, error) {
+	fallback := func() (string, *ragv1.RagAnswer, error) {
+		summary := s.generateBasicContextSummary(chunks, query)
+		if err := emit(summary); err != nil {
+			return "", nil, err
+		}
+		return summary, nil, nil
+	}
+
+	messages := s.buildContextSummaryMessages(chunks, query, systemPrompt)
+	if len(messages) == 0 {
+		return fallback()
+	}
+	if s.LLM == nil || s.Config == nil {
+		logger.Get().Warn("LLM service not initialized, falling back to basic summary")
+		return fallback()
+	}
+
+	llmStream, err := s.LLM.CreateChatCompletionStream(ctx, pkgopenai.TaskSummary, model, messages)
+	if err != nil {
+		logger.Get().Error("LLM流式总结失败，回退到基础模板", slog.Any("error", err))
+		return fallback()
+	}
+	defer llmStream.Close()
+
+	var summary strings.Builder
+	for {
+		chunk, err := llmStream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// The client went away; the stream is closed and nothing is left to send to.
+			if ctx.Err() != nil {
+				return "", nil, ctx.Err()
+			}
+			if summary.Len() == 0 {
+				logger.Get().Error("LLM流式总结失败，回退到基础模板", slog.Any("error", err))
+				return fallback()
+			}
+			return "", nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("summary stream interrupted: %w", err))
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+		delta := chunk.Choices[0].Delta.Content
+		if err := emit(delta); err != nil {
+			return "", nil, err
+		}
+		summary.WriteString(delta)
+	}
+
+	if summary.Len() == 0 {
+		logger.Get().Warn("LLM返回空内容，回退到基础模板")
+		return fallback()
+	}
+
+	answer, text := s.parseRagAnswer(ctx, messages, model, summary.String(), systemPrompt == "")
+	if err := emit(contextSummaryFooter); err != nil {
+		return "", nil, err
+	}
+	return text + contextSummaryFooter, answer, nil
+}
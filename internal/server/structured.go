@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+
+	"github.com/hsn0918/rag/pkg/clients/openai"
+	"github.com/hsn0918/rag/pkg/prompts"
+)
+
+// promptManager returns the prompt manager of the prompt embedding service,
+// or a fresh one with the default prompts.
+func (s *RagServer) promptManager() *prompts.PromptManager {
+	if s.promptEmbeddingService != nil {
+		return s.promptEmbeddingService.GetPromptManager()
+	}
+	return prompts.NewPromptManager()
+}
+
+// completePrompt sends a rendered prompt for task. Providers with structured
+// output get the prompt's JSON variant and response format, the others its
+// text format; the response's Structured field tells which was answered.
+func (s *RagServer) completePrompt(ctx context.Context, task openai.Task, model string, prompt *prompts.Prompt, userContent string) (*openai.StructuredResponse, error) {
+	fallback := []openai.Message{
+		{Role: "system", Content: prompt.System},
+		{Role: "user", Content: userContent},
+	}
+	if prompt.Output == nil {
+		resp, err := s.LLM.CreateChatCompletion(ctx, task, model, fallback)
+		if err != nil {
+			return nil, err
+		}
+		return &openai.StructuredResponse{ChatResponse: resp}, nil
+	}
+
+	return s.LLM.CreateStructuredCompletion(ctx, task, model, openai.StructuredRequest{
+		Schema: openai.JSONSchema{
+			Name:   prompt.Output.Name,
+			Schema: prompt.Output.Schema,
+			Strict: true,
+		},
+		Messages: []openai.Message{
+			{Role: "system", Content: prompt.Output.System},
+			{Role: "user", Content: userContent},
+		},
+		Fallback: fallback,
+	})
+}
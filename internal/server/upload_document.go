@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/hsn0918/rag/internal/adapters"
+	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
+	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/hsn0918/rag/pkg/parsers"
+)
+
+var dedupePolicyFromProto = map[ragv1.DedupePolicy]adapters.DedupePolicy{
+	ragv1.DedupePolicy_DEDUPE_POLICY_REJECT:          adapters.DedupeReject,
+	ragv1.DedupePolicy_DEDUPE_POLICY_RETURN_EXISTING: adapters.DedupeReturnExisting,
+	ragv1.DedupePolicy_DEDUPE_POLICY_REPLACE:         adapters.DedupeReplace,
+}
+
+// UploadDocument queues an uploaded document for ingestion and returns
+// immediately.
+//
+// The parser is chosen from the registry by file extension, falling back
+// to content_type: Markdown and text are chunked as-is, HTML and DOCX are
+// converted to Markdown locally and PDF goes through Doc2X or the local
+// extractor, as configured. Downloading, parsing, chunking and embedding
+// run in the background ingestion workers; clients poll GetIngestionJob
+// with the returned job ID.
+//
+// With a collection_id the document joins that collection and is ingested
+// with its chunking and embedding settings. Tags and uploader are stored in
+// the document's metadata, where search filters match them.
+//
+// The file's MD5 is checked against existing documents in the same
+// collection first. Duplicates
+// are rejected, answered with the existing document, or re-ingested to
+// replace it, depending on the dedupe policy; the response reports which.
+func (s *RagServer) UploadDocument(
+	ctx context.Context,
+	req *connect.Request[ragv1.UploadDocumentRequest],
+) (*connect.Response[ragv1.UploadDocumentResponse], error) {
+	resp, err := s.submitUpload(ctx, adapters.IngestionJobInput{
+		FileKey:      req.Msg.GetFileKey(),
+		Filename:     req.Msg.GetFilename(),
+		ContentType:  req.Msg.GetContentType(),
+		CollectionID: req.Msg.GetCollectionId(),
+		Tags:         req.Msg.GetTags(),
+		Uploader:     req.Msg.GetUploader(),
+	}, req.Msg.GetDedupePolicy())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// submitUpload applies the dedupe policy and enqueues an ingestion job for
+// input; input.DedupePolicy is resolved from dedupePolicy and the config.
+func (s *RagServer) submitUpload(
+	ctx context.Context,
+	input adapters.IngestionJobInput,
+	dedupePolicy ragv1.DedupePolicy,
+) (*ragv1.UploadDocumentResponse, error) {
+	fileKey, collectionID := input.FileKey, input.CollectionID
+	if _, err := s.Parsers.Lookup(input.Filename, input.ContentType); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s", err, input.Filename))
+	}
+	if _, err := s.lookupCollection(ctx, collectionID); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.Storage.CheckFileExists(ctx, fileKey)
+	if err != nil {
+		logger.Get().Error("failed to check file existence", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check file existence: %w", err))
+	}
+	if !exists {
+		logger.Get().Error("file not found in storage", "file_key", fileKey)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("file not found in storage: %s", fileKey))
+	}
+
+	policy, ok := dedupePolicyFromProto[dedupePolicy]
+	if !ok {
+		policy = adapters.DedupePolicy(s.Config.Ingestion.DedupePolicy)
+	}
+	input.DedupePolicy = policy
+
+	md5Hash, err := s.hashStoredFile(ctx, fileKey)
+	if err != nil {
+		logger.Get().Error("failed to hash uploaded file", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to hash uploaded file: %w", err))
+	}
+
+	result := ragv1.DedupeResult_DEDUPE_RESULT_CREATED
+	existing, err := s.DB.FindDocumentByContentHash(ctx, collectionID, md5Hash)
+	switch {
+	case errors.Is(err, adapters.ErrDocumentNotFound):
+	case err != nil:
+		logger.Get().Error("failed to look up duplicate document", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up duplicate document: %w", err))
+	default:
+		logger.Get().Info("duplicate upload detected",
+			"file_key", fileKey,
+			"md5_hash", md5Hash,
+			"existing_document_id", existing.ID,
+			"policy", policy,
+		)
+		switch policy {
+		case adapters.DedupeReject:
+			return &ragv1.UploadDocumentResponse{
+				Success:      false,
+				Message:      fmt.Sprintf("Duplicate of existing document %s; upload rejected", existing.ID),
+				DocumentId:   existing.ID,
+				DedupeResult: ragv1.DedupeResult_DEDUPE_RESULT_REJECTED,
+			}, nil
+		case adapters.DedupeReplace:
+			result = ragv1.DedupeResult_DEDUPE_RESULT_REPLACED
+		default:
+			return &ragv1.UploadDocumentResponse{
+				Success:      true,
+				Message:      fmt.Sprintf("Duplicate of existing document %s", existing.ID),
+				DocumentId:   existing.ID,
+				DedupeResult: ragv1.DedupeResult_DEDUPE_RESULT_EXISTING,
+			}, nil
+		}
+	}
+
+	job, err := s.Ingestion.Enqueue(ctx, input)
+	if err != nil {
+		logger.Get().Error("failed to enqueue ingestion job", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to enqueue ingestion job: %w", err))
+	}
+	logger.Get().Info("ingestion job queued", "job_id", job.ID, "file_key", fileKey, "collection_id", collectionID)
+
+	return &ragv1.UploadDocumentResponse{
+		Success:      true,
+		Message:      fmt.Sprintf("Document queued for processing. Job ID: %s", job.ID),
+		JobId:        job.ID,
+		DedupeResult: result,
+	}, nil
+}
+
+// hashStoredFile streams the stored object through MD5 without buffering it.
+func (s *RagServer) hashStoredFile(ctx context.Context, fileKey string) (string, error) {
+	object, err := s.Storage.DownloadFile(ctx, fileKey)
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, object); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// pdfParser parses PDFs with Doc2X and/or the local extractor, reusing the
+// MinIO cache of Doc2X output keyed by the PDF's MD5. The extractor that
+// produced the text is reported in Document.Extractor.
+type pdfParser struct {
+	server *RagServer
+}
+
+// Name implements parsers.DocumentParser.
+func (p pdfParser) Name() string { return "pdf" }
+
+// Parse implements parsers.DocumentParser.
+func (p pdfParser) Parse(ctx context.Context, in parsers.Input) (*parsers.Document, error) {
+	return p.server.processPDFWithCaching(ctx, in.Data, in.OnProgress)
+}
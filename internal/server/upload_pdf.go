@@ -2,141 +2,54 @@ package server
 
 import (
 	"context"
-	"crypto/md5"
-	"fmt"
-	"io"
 	"log/slog"
 	"regexp"
 	"strings"
-	"time"
 
 	"connectrpc.com/connect"
 
+	"github.com/hsn0918/rag/internal/adapters"
 	ragv1 "github.com/hsn0918/rag/internal/gen/rag/v1"
 	"github.com/hsn0918/rag/pkg/chunking"
+	"github.com/hsn0918/rag/pkg/config"
 	"github.com/hsn0918/rag/pkg/logger"
 )
 
 var consecutiveNewlines = regexp.MustCompile(`\n{3,}`)
 
+// UploadPdf queues the uploaded PDF for ingestion and returns immediately.
+//
+// It is UploadDocument restricted to PDF files, kept for existing clients.
 func (s *RagServer) UploadPdf(
 	ctx context.Context,
 	req *connect.Request[ragv1.UploadPdfRequest],
 ) (*connect.Response[ragv1.UploadPdfResponse], error) {
-	fileKey := req.Msg.GetFileKey()
-	filename := req.Msg.GetFilename()
-	exists, err := s.Storage.CheckFileExists(ctx, fileKey)
+	resp, err := s.submitUpload(ctx, adapters.IngestionJobInput{
+		FileKey:      req.Msg.GetFileKey(),
+		Filename:     req.Msg.GetFilename(),
+		ContentType:  "application/pdf",
+		CollectionID: req.Msg.GetCollectionId(),
+		Tags:         req.Msg.GetTags(),
+		Uploader:     req.Msg.GetUploader(),
+	}, req.Msg.GetDedupePolicy())
 	if err != nil {
-		logger.Get().Error("failed to check file existence", "error", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check file existence: %w", err))
-	}
-	if !exists {
-		logger.Get().Error("file not found in storage", "file_key", fileKey)
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("file not found in storage: %s", fileKey))
-	}
-	object, err := s.Storage.DownloadFile(ctx, fileKey)
-	if err != nil {
-		logger.Get().Error("failed to download file", "error", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to download file: %w", err))
-	}
-	defer object.Close()
-	pdfData, err := io.ReadAll(object)
-	if err != nil {
-		logger.Get().Error("failed to read PDF data", "error", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read PDF data: %w", err))
-	}
-	if len(pdfData) == 0 {
-		logger.Get().Error("PDF file is empty")
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("PDF file is empty"))
-	}
-
-	textContent, pageCount, err := s.processPDFWithCaching(ctx, pdfData)
-	if err != nil {
-		logger.Get().Error("failed to process PDF with caching", "error", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to process PDF with caching: %w", err))
-	}
-	if textContent == "" {
-		logger.Get().Error("no text extracted from PDF")
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("no text extracted from PDF"))
-	}
-
-	textContent = s.cleanEmptyLines(textContent)
-	md5Hash := fmt.Sprintf("%x", md5.Sum(pdfData))
-	doc2xUID := fmt.Sprintf("processed_%s", md5Hash)
-
-	chunks, err := s.chunkTextContent(textContent)
-	if err != nil {
-		logger.Get().Error("failed to chunk text", "error", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to chunk text: %w", err))
-	}
-
-	// [REVERTED] Store the main document directly, without a transaction.
-	docID, err := s.DB.StoreDocument(ctx, filename, req.Msg.GetFileKey(), map[string]any{
-		"source":     filename,
-		"pages":      pageCount,
-		"doc2x_uid":  doc2xUID,
-		"md5_hash":   md5Hash,
-		"created_at": time.Now(),
-	})
-	if err != nil {
-		logger.Get().Error("failed to store document", "error", err)
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to store document: %w", err))
-	}
-
-	// Process chunks sequentially. Each database call is an independent operation.
-	successfulChunks := 0
-	for i, chunk := range chunks {
-		cleanContent := s.cleanText(chunk.Content)
-
-		embeddingVec, err := s.generateEmbedding(ctx, cleanContent)
-		if err != nil {
-			logger.Get().Error("Failed to generate embedding for chunk", slog.Int("chunk_id", i), slog.Any("error", err))
-			continue
-		}
-
-		metadata := make(map[string]any)
-		for k, v := range chunk.Metadata {
-			metadata[k] = v
-		}
-		metadata["chunk_length"] = len(cleanContent)
-		metadata["chunk_type"] = chunk.Type
-		metadata["chunk_title"] = chunk.Title
-
-		// [REVERTED] Store each chunk directly, without a transaction.
-		err = s.DB.StoreChunk(ctx, docID, i, cleanContent, embeddingVec, metadata)
-		if err != nil {
-			logger.Get().Error("Failed to store chunk", slog.Int("chunk_id", i), slog.Any("error", err))
-			continue
-		}
-
-		successfulChunks++
-	}
-
-	// Cache the document information.
-	err = s.Cache.CacheDocument(ctx, docID, map[string]any{
-		"title":     filename,
-		"content":   textContent,
-		"doc2x_uid": doc2xUID,
-		"md5_hash":  md5Hash,
-		"chunks":    len(chunks),
-	})
-	if err != nil {
-		logger.Get().Warn("Failed to cache document", slog.String("doc_id", docID), slog.Any("error", err))
+		return nil, err
 	}
-
 	return connect.NewResponse(&ragv1.UploadPdfResponse{
-		Success:    true,
-		Message:    fmt.Sprintf("PDF processed successfully. Document ID: %s, Chunks: %d", docID, successfulChunks),
-		DocumentId: docID,
+		Success:      resp.GetSuccess(),
+		Message:      resp.GetMessage(),
+		DocumentId:   resp.GetDocumentId(),
+		JobId:        resp.GetJobId(),
+		DedupeResult: resp.GetDedupeResult(),
 	}), nil
 }
 
-// chunkTextContent applies semantic-aware chunking to text content
-func (s *RagServer) chunkTextContent(content string) ([]chunking.Chunk, error) {
-	chunkConfig := s.Config.Chunking
-
+// chunkTextContent applies semantic-aware chunking to text content, using
+// embeddingModel at embeddingDimensions for semantic similarity. It only
+// falls back to standard chunking while ctx is live.
+func (s *RagServer) chunkTextContent(ctx context.Context, content string, chunkConfig config.ChunkingConfig, embeddingModel string, embeddingDimensions int) ([]chunking.Chunk, error) {
 	// Check if semantic chunking is enabled
-	useSemanticChunking := s.Config.Chunking.EnableSemantic
+	useSemanticChunking := chunkConfig.EnableSemantic
 
 	if useSemanticChunking && s.Embedding != nil {
 		logger.Get().Info("Using semantic chunking")
@@ -145,16 +58,22 @@ func (s *RagServer) chunkTextContent(content string) ([]chunking.Chunk, error) {
 			chunkConfig.MaxChunkSize,
 			chunkConfig.MinChunkSize,
 			s.Embedding,
-			chunking.WithModel(s.Config.Services.Embedding.Model),
-			chunking.WithSimilarityThreshold(s.Config.Chunking.SimilarityThreshold),
+			chunking.WithModel(embeddingModel),
+			chunking.WithDimensions(embeddingDimensions),
+			chunking.WithSimilarityThreshold(chunkConfig.SimilarityThreshold),
 			chunking.WithParallelProcessing(true),
+			chunking.WithBatchSize(s.Config.Services.Embedding.BatchSize),
+			chunking.WithMaxBatchTokens(s.Config.Services.Embedding.MaxBatchTokens),
+			chunking.WithCache(s.embeddingCache(embeddingModel, embeddingDimensions)),
 		)
 		if err != nil {
 			logger.Get().Error("Failed to create semantic chunker, falling back to standard chunking", "error", err)
 			// Fall back to standard chunking
 		} else {
-			ctx := context.Background()
 			chunks, err := semanticChunker.ChunkText(ctx, content)
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			if err != nil {
 				logger.Get().Error("Semantic chunking failed, falling back to standard chunking", "error", err)
 				// Fall back to standard chunking
@@ -192,6 +111,8 @@ func (s *RagServer) chunkTextContent(content string) ([]chunking.Chunk, error) {
 	return chunker.ChunkMarkdown(content)
 }
 
+// cleanEmptyLines collapses runs of blank lines. Page markers sit on lines
+// of their own, so they are kept for the chunker to map chunks to pages.
 func (s *RagServer) cleanEmptyLines(content string) string {
 	normalized := strings.ReplaceAll(content, "\r\n", "\n")
 	cleaned := consecutiveNewlines.ReplaceAllString(normalized, "\n\n")
@@ -6,6 +6,7 @@ import (
 	"crypto/md5"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -13,7 +14,11 @@ import (
 
 	"connectrpc.com/connect"
 	pkgdoc2x "github.com/hsn0918/rag/pkg/clients/doc2x"
+	pkgembedding "github.com/hsn0918/rag/pkg/clients/embedding"
+	"github.com/hsn0918/rag/pkg/config"
 	"github.com/hsn0918/rag/pkg/logger"
+	"github.com/hsn0918/rag/pkg/parsers"
+	"github.com/hsn0918/rag/pkg/redis"
 	"log/slog"
 )
 
@@ -40,11 +45,22 @@ func (s *RagServer) cleanText(text string) string {
 	return strings.TrimSpace(text)
 }
 
-// generateEmbedding 使用嵌入客户端生成文本的向量表示
-func (s *RagServer) generateEmbedding(ctx context.Context, text string) ([]float32, error) {
+// embeddingCacheKey 区分同一模型不同输出维度的缓存向量
+func embeddingCacheKey(model string, dimensions int) string {
+	if dimensions == 0 {
+		return model
+	}
+	return fmt.Sprintf("%s@%d", model, dimensions)
+}
+
+// generateEmbedding 使用嵌入客户端以指定模型生成文本的向量表示，
+// dimensions 为请求的输出维度，0 表示模型原生维度
+func (s *RagServer) generateEmbedding(ctx context.Context, model string, dimensions int, text string) ([]float32, error) {
+	cacheKey := embeddingCacheKey(model, dimensions)
+
 	// 检查缓存
 	if s.Cache != nil {
-		cachedEmbedding, err := s.Cache.GetEmbedding(ctx, text)
+		cachedEmbedding, err := s.Cache.GetEmbedding(ctx, cacheKey, text)
 		if err == nil && len(cachedEmbedding) > 0 {
 			return cachedEmbedding, nil
 		}
@@ -59,7 +75,12 @@ func (s *RagServer) generateEmbedding(ctx context.Context, text string) ([]float
 	}
 
 	// 调用嵌入服务
-	embeddingResp, err := s.Embedding.CreateEmbeddingWithDefaults(s.Config.Services.Embedding.Model, text)
+	embeddingResp, err := s.Embedding.CreateEmbedding(ctx, pkgembedding.Request{
+		Model:          model,
+		Input:          text,
+		EncodingFormat: "float",
+		Dimensions:     dimensions,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get embedding: %w", err)
 	}
@@ -76,21 +97,126 @@ func (s *RagServer) generateEmbedding(ctx context.Context, text string) ([]float
 
 	// 缓存结果
 	if s.Cache != nil {
-		_ = s.Cache.CacheEmbedding(ctx, text, embeddingVec)
+		_ = s.Cache.CacheEmbedding(ctx, cacheKey, text, embeddingVec)
 	}
 
 	return embeddingVec, nil
 }
 
-// processPDFWithCaching handles PDF processing with caching logic
-func (s *RagServer) processPDFWithCaching(ctx context.Context, pdfData []byte) (string, int, error) {
+// embeddingCache 将 Redis 向量缓存适配为 embedding.Cache
+type embeddingCache struct {
+	cache *redis.CacheService
+	model string
+}
+
+func (c embeddingCache) Get(ctx context.Context, text string) ([]float32, bool) {
+	embedding, err := c.cache.GetEmbedding(ctx, c.model, text)
+	return embedding, err == nil && len(embedding) > 0
+}
+
+func (c embeddingCache) Set(ctx context.Context, text string, embedding []float32) {
+	_ = c.cache.CacheEmbedding(ctx, c.model, text, embedding)
+}
+
+// embeddingCache 返回模型在指定维度下的 Redis 向量缓存，未配置 Redis 时返回 nil。
+// 摄取与语义分块共用该缓存，重新分块同一文档时不会重复生成向量
+func (s *RagServer) embeddingCache(model string, dimensions int) pkgembedding.Cache {
+	if s.Cache == nil {
+		return nil
+	}
+	return embeddingCache{cache: s.Cache, model: embeddingCacheKey(model, dimensions)}
+}
+
+// generateEmbeddings 以指定模型批量生成文本向量，结果与 texts 一一对应。
+// 部分批次失败时返回 *embedding.BatchError，失败位置为 nil。
+func (s *RagServer) generateEmbeddings(ctx context.Context, model string, dimensions int, texts []string, onProgress func(embedded, failed int)) ([][]float32, error) {
+	if s.Embedding == nil {
+		return nil, fmt.Errorf("embedding service is not initialized")
+	}
+	if s.Config == nil {
+		return nil, fmt.Errorf("embedding service configuration is missing")
+	}
+
+	embeddingCfg := s.Config.Services.Embedding
+	opts := []pkgembedding.BatchOption{
+		pkgembedding.WithBatchSize(embeddingCfg.BatchSize),
+		pkgembedding.WithMaxBatchTokens(embeddingCfg.MaxBatchTokens),
+		pkgembedding.WithProgress(onProgress),
+		pkgembedding.WithDimensions(dimensions),
+	}
+	if cache := s.embeddingCache(model, dimensions); cache != nil {
+		opts = append(opts, pkgembedding.WithCache(cache))
+	}
+
+	return pkgembedding.BatchEmbed(ctx, s.Embedding, model, texts, opts...)
+}
+
+// Names of the PDF extractors recorded in document metadata.
+const (
+	extractorDoc2X    = "doc2x"
+	extractorLocalPDF = "local_pdf"
+)
+
+// processPDFWithCaching extracts a PDF's text with Doc2X, the local
+// extractor, or both, according to services.doc2x.local_extractor. The
+// returned document's Extractor records which one produced the text.
+// onParseProgress, when non-nil, receives parse progress (0-100).
+func (s *RagServer) processPDFWithCaching(ctx context.Context, pdfData []byte, onParseProgress func(progress int)) (*parsers.Document, error) {
+	input := parsers.Input{Data: pdfData, OnProgress: onParseProgress}
+	doc2xAvailable := s.Doc2X != nil && s.Config.Services.Doc2X.APIKey != ""
+
+	switch s.Config.Services.Doc2X.LocalExtractor {
+	case config.LocalExtractorDisabled:
+		return s.processPDFWithDoc2X(ctx, pdfData, onParseProgress)
+
+	case config.LocalExtractorPrimary:
+		doc, err := s.processPDFLocally(ctx, input)
+		if err == nil || !doc2xAvailable || ctx.Err() != nil {
+			return doc, err
+		}
+		// 本地提取失败（如扫描件没有文本层），交给 Doc2X 处理
+		logger.Get().Warn("本地PDF提取失败，改用Doc2X", slog.Any("error", err))
+		return s.processPDFWithDoc2X(ctx, pdfData, onParseProgress)
+
+	default:
+		if !doc2xAvailable {
+			logger.Get().Warn("Doc2X未配置，使用本地PDF提取")
+			return s.processPDFLocally(ctx, input)
+		}
+		doc, err := s.processPDFWithDoc2X(ctx, pdfData, onParseProgress)
+		if err == nil || ctx.Err() != nil {
+			return doc, err
+		}
+		logger.Get().Warn("Doc2X处理失败，回退到本地PDF提取", slog.Any("error", err))
+		localDoc, localErr := s.processPDFLocally(ctx, input)
+		if localErr != nil {
+			return nil, errors.Join(err, localErr)
+		}
+		return localDoc, nil
+	}
+}
+
+// processPDFLocally extracts the PDF's text layer with the pure-Go
+// extractor. Its output is not cached since extraction is cheap and a later
+// Doc2X run should not be shadowed by it.
+func (s *RagServer) processPDFLocally(ctx context.Context, input parsers.Input) (*parsers.Document, error) {
+	doc, err := parsers.LocalPDFParser{}.Parse(ctx, input)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("local PDF extraction failed: %w", err))
+	}
+	doc.Extractor = extractorLocalPDF
+	logger.Get().Info("PDF text extracted locally", slog.Int("pages", doc.Pages), slog.Int("length", len(doc.Content)))
+	return doc, nil
+}
+
+// processPDFWithDoc2X handles Doc2X processing with MinIO caching of the
+// processed text.
+func (s *RagServer) processPDFWithDoc2X(ctx context.Context, pdfData []byte, onParseProgress func(progress int)) (*parsers.Document, error) {
 	// 计算PDF文件的MD5摘要
 	md5Hash := fmt.Sprintf("%x", md5.Sum(pdfData))
 
 	// 检查MinIO中是否有已处理的文本内容
 	processedTextKey := fmt.Sprintf("processed/%s.txt", md5Hash)
-	var textContent string
-	var pageCount int
 
 	// 首先检查MinIO中是否有处理后的文本
 	processedExists, err := s.Storage.CheckFileExists(ctx, processedTextKey)
@@ -100,31 +226,26 @@ func (s *RagServer) processPDFWithCaching(ctx context.Context, pdfData []byte) (
 
 		object, err := s.Storage.DownloadFile(ctx, processedTextKey)
 		if err != nil {
-			return "", 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to download cached processed text: %w", err))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to download cached processed text: %w", err))
 		}
 		defer object.Close()
 
 		textBytes, err := io.ReadAll(object)
 		if err != nil {
-			return "", 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read cached processed text: %w", err))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read cached processed text: %w", err))
 		}
 
-		textContent = string(textBytes)
-		pageCount = 0 // 无法从缓存中获取页数，使用默认值
-		logger.Get().Info("Successfully loaded processed text from MinIO cache", slog.Int("length", len(textContent)))
-	} else {
-		// MinIO中没有缓存，需要处理PDF
-		textContent, pageCount, err = s.processWithDoc2X(ctx, pdfData, md5Hash, processedTextKey)
-		if err != nil {
-			return "", 0, err
-		}
+		logger.Get().Info("Successfully loaded processed text from MinIO cache", slog.Int("length", len(textBytes)))
+		// 无法从缓存中获取页数，使用默认值
+		return &parsers.Document{Content: string(textBytes), Extractor: extractorDoc2X}, nil
 	}
 
-	return textContent, pageCount, nil
+	// MinIO中没有缓存，需要处理PDF
+	return s.processWithDoc2X(ctx, pdfData, md5Hash, processedTextKey, onParseProgress)
 }
 
 // processWithDoc2X handles Doc2X processing with Redis caching
-func (s *RagServer) processWithDoc2X(ctx context.Context, pdfData []byte, md5Hash, processedTextKey string) (string, int, error) {
+func (s *RagServer) processWithDoc2X(ctx context.Context, pdfData []byte, md5Hash, processedTextKey string, onParseProgress func(progress int)) (*parsers.Document, error) {
 	// 检查Redis中的Doc2X响应缓存
 	logger.Get().Info("MinIO processed text cache miss, checking Redis cache", slog.String("md5", md5Hash))
 
@@ -140,19 +261,19 @@ func (s *RagServer) processWithDoc2X(ctx context.Context, pdfData []byte, md5Has
 		logger.Get().Info("Both caches miss, processing with Doc2X", slog.String("md5", md5Hash))
 
 		// 使用 Doc2X 客户端上传并处理 PDF
-		uploadResp, err := s.Doc2X.UploadPDF(pdfData)
+		uploadResp, err := s.Doc2X.UploadPDF(ctx, pdfData)
 		if err != nil {
-			return "", 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to upload PDF to Doc2X: %w", err))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to upload PDF to Doc2X: %w", err))
 		}
 
 		if uploadResp.Code != "success" {
-			return "", 0, connect.NewError(connect.CodeInternal, fmt.Errorf("Doc2X upload failed: %s", uploadResp.Code))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Doc2X upload failed: %s", uploadResp.Code))
 		}
 
 		// 等待处理完成
-		statusResp, err = s.Doc2X.WaitForParsing(uploadResp.Data.UID, 5*time.Second)
+		statusResp, err = s.Doc2X.WaitForParsingWithProgress(ctx, uploadResp.Data.UID, 5*time.Second, onParseProgress)
 		if err != nil {
-			return "", 0, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse PDF: %w", err))
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse PDF: %w", err))
 		}
 
 		// 缓存Redis响应结果
@@ -167,20 +288,21 @@ func (s *RagServer) processWithDoc2X(ctx context.Context, pdfData []byte, md5Has
 	}
 
 	if statusResp.Data == nil || statusResp.Data.Result == nil {
-		return "", 0, connect.NewError(connect.CodeInternal, fmt.Errorf("parsing result is empty"))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("parsing result is empty"))
 	}
 
-	// 提取文本内容
-	var allText strings.Builder
-	for _, page := range statusResp.Data.Result.Pages {
-		if page.Md != "" {
-			allText.WriteString(page.Md)
-			allText.WriteString("\n\n")
+	// 提取文本内容，按 PageIdx 归位并插入页码标记，缓存文本中也保留页边界
+	pages := statusResp.Data.Result.Pages
+	pageTexts := make([]string, len(pages))
+	for i, page := range pages {
+		idx := i
+		if page.PageIdx >= 0 && page.PageIdx < len(pages) {
+			idx = page.PageIdx
 		}
+		pageTexts[idx] = page.Md
 	}
 
-	textContent := allText.String()
-	pageCount := len(statusResp.Data.Result.Pages)
+	textContent := parsers.JoinPages(pageTexts)
 
 	// 将处理后的文本内容缓存到MinIO
 	if textContent != "" {
@@ -193,5 +315,10 @@ func (s *RagServer) processWithDoc2X(ctx context.Context, pdfData []byte, md5Has
 		}
 	}
 
-	return textContent, pageCount, nil
+	return &parsers.Document{
+		Content:   textContent,
+		Pages:     len(pageTexts),
+		PageTexts: pageTexts,
+		Extractor: extractorDoc2X,
+	}, nil
 }
@@ -6,10 +6,13 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
+	"github.com/hsn0918/rag/pkg/parsers"
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/extension"
@@ -50,12 +53,18 @@ var (
 )
 
 type Chunk struct {
-	Content       string            `json:"content"`
-	Type          string            `json:"type"`
-	Level         int               `json:"level"`
-	Title         string            `json:"title"`
-	StartIndex    int               `json:"start_index"`
-	EndIndex      int               `json:"end_index"`
+	Content string `json:"content"`
+	Type    string `json:"type"`
+	Level   int    `json:"level"`
+	Title   string `json:"title"`
+	// StartIndex and EndIndex are the character (rune) offsets of the
+	// chunk's source span in the chunked text, not counting page markers.
+	StartIndex int `json:"start_index"`
+	EndIndex   int `json:"end_index"`
+	// PageStart and PageEnd are the 1-based pages the span covers, taken
+	// from the page markers in the text; zero when it has none.
+	PageStart     int               `json:"page_start,omitempty"`
+	PageEnd       int               `json:"page_end,omitempty"`
 	Metadata      map[string]string `json:"metadata"`
 	ID            string            `json:"id,omitempty"`
 	TokenCount    int               `json:"token_count,omitempty"`
@@ -155,6 +164,7 @@ func (omc *OptimizedMarkdownChunker) ChunkMarkdown(content string) ([]Chunk, err
 		chunks = omc.mergeSparseParentChunks(chunks)
 	}
 	optimized := omc.postProcessChunks(chunks)
+	omc.locateChunks(optimized, cleanContent)
 	return optimized, nil
 }
 
@@ -293,28 +303,75 @@ func (omc *OptimizedMarkdownChunker) intelligentChunking(root *DocumentNode, sou
 	return chunks
 }
 
+// processNodeForChunking chunks the top-level sections of the document in
+// order. Content outside any heading, such as a preamble or plain text
+// without headings, is chunked as untitled sections.
 func (omc *OptimizedMarkdownChunker) processNodeForChunking(node *DocumentNode, source []byte, chunks *[]Chunk, chunkID *int) {
-	stack := []*DocumentNode{node}
+	var loose []*DocumentNode
+	flush := func() {
+		if len(loose) == 0 {
+			return
+		}
+		section := &DocumentNode{Type: ast.KindDocument, Children: loose}
+		if len(loose) == 1 {
+			section.Type = loose[0].Type
+		}
+		omc.chunkSection(section, source, chunks, chunkID)
+		loose = nil
+	}
+	for _, child := range node.Children {
+		if child.Type != ast.KindHeading {
+			loose = append(loose, child)
+			continue
+		}
+		flush()
+		omc.chunkSection(child, source, chunks, chunkID)
+	}
+	flush()
+}
+
+func (omc *OptimizedMarkdownChunker) chunkSection(section *DocumentNode, source []byte, chunks *[]Chunk, chunkID *int) {
+	sectionContent := omc.collectSectionContent(section)
+	if strings.TrimSpace(sectionContent) == "" {
+		return
+	}
+	start, end := omc.sectionSpan(section, source)
+	if len(sectionContent) <= omc.maxChunkSize {
+		chunk := omc.createChunk(*chunkID, sectionContent, section)
+		chunk.StartIndex, chunk.EndIndex = start, end
+		*chunks = append(*chunks, chunk)
+		*chunkID++
+		return
+	}
+	subChunks := omc.splitLargeContent(sectionContent, section, *chunkID, source, start, end)
+	*chunks = append(*chunks, subChunks...)
+	*chunkID += len(subChunks)
+}
+
+// sectionSpan returns the byte span in source covered by section and its
+// descendants, starting at the beginning of the heading line.
+func (omc *OptimizedMarkdownChunker) sectionSpan(section *DocumentNode, source []byte) (int, int) {
+	start, end := -1, 0
+	stack := []*DocumentNode{section}
 	for len(stack) > 0 {
-		currentNode := stack[len(stack)-1]
+		node := stack[len(stack)-1]
 		stack = stack[:len(stack)-1]
-		if currentNode.Type == ast.KindHeading {
-			sectionContent := omc.collectSectionContent(currentNode)
-			if len(sectionContent) <= omc.maxChunkSize {
-				chunk := omc.createChunk(*chunkID, sectionContent, currentNode)
-				*chunks = append(*chunks, chunk)
-				*chunkID++
-			} else {
-				subChunks := omc.splitLargeContent(sectionContent, currentNode, *chunkID)
-				*chunks = append(*chunks, subChunks...)
-				*chunkID += len(subChunks)
-			}
-		} else {
-			for i := len(currentNode.Children) - 1; i >= 0; i-- {
-				stack = append(stack, currentNode.Children[i])
+		// Container nodes such as lists have no lines of their own.
+		if node.Content != "" {
+			if start < 0 || node.StartIndex < start {
+				start = node.StartIndex
 			}
+			end = max(end, node.EndIndex)
 		}
+		stack = append(stack, node.Children...)
+	}
+	if start < 0 {
+		return 0, 0
 	}
+	if start > 0 {
+		start = bytes.LastIndexByte(source[:start], '\n') + 1
+	}
+	return start, end
 }
 
 func (omc *OptimizedMarkdownChunker) collectSectionContent(section *DocumentNode) string {
@@ -341,11 +398,17 @@ func (omc *OptimizedMarkdownChunker) collectChildContent(node *DocumentNode, con
 	}
 }
 
-func (omc *OptimizedMarkdownChunker) splitLargeContent(content string, section *DocumentNode, startID int) []Chunk {
+// splitLargeContent splits a section into parts of at most maxChunkSize.
+// Each part's span is found by locating its paragraphs in source between
+// spanStart and spanEnd; paragraphs that are not verbatim copies of the
+// source, such as rebuilt headings, leave the span unchanged.
+func (omc *OptimizedMarkdownChunker) splitLargeContent(content string, section *DocumentNode, startID int, source []byte, spanStart, spanEnd int) []Chunk {
 	var chunks []Chunk
 	paragraphs := omc.smartSplitByParagraphs(content)
 	var currentChunk strings.Builder
 	chunkIndex := 0
+	cursor := spanStart
+	partStart, partEnd := spanStart, spanStart
 	for _, para := range paragraphs {
 		para = strings.TrimSpace(para)
 		if para == "" {
@@ -358,9 +421,11 @@ func (omc *OptimizedMarkdownChunker) splitLargeContent(content string, section *
 		potential += para
 		if len(potential) > omc.maxChunkSize && currentChunk.Len() > omc.minChunkSize {
 			chunk := omc.createChunk(startID+chunkIndex, strings.TrimSpace(currentChunk.String()), section)
+			chunk.StartIndex, chunk.EndIndex = partStart, partEnd
 			omc.addPartialChunkMetadata(&chunk, section.Title, chunkIndex)
 			chunks = append(chunks, chunk)
 			currentChunk.Reset()
+			partStart = -1
 			if omc.overlapSize > 0 && len(chunks) > 0 {
 				overlap := omc.getSmartOverlap(chunks[len(chunks)-1].Content)
 				if overlap != "" {
@@ -370,6 +435,18 @@ func (omc *OptimizedMarkdownChunker) splitLargeContent(content string, section *
 			}
 			chunkIndex++
 		}
+		if cursor <= spanEnd && spanEnd <= len(source) {
+			if pos := bytes.Index(source[cursor:spanEnd], []byte(para)); pos >= 0 {
+				if partStart < 0 {
+					partStart = bytes.LastIndexByte(source[:cursor+pos], '\n') + 1
+				}
+				cursor += pos + len(para)
+				partEnd = cursor
+			}
+		}
+		if partStart < 0 {
+			partStart = partEnd
+		}
 		if currentChunk.Len() > 0 {
 			currentChunk.WriteString("\n\n")
 		}
@@ -377,6 +454,7 @@ func (omc *OptimizedMarkdownChunker) splitLargeContent(content string, section *
 	}
 	if currentChunk.Len() > 0 {
 		chunk := omc.createChunk(startID+chunkIndex, strings.TrimSpace(currentChunk.String()), section)
+		chunk.StartIndex, chunk.EndIndex = partStart, max(partEnd, spanEnd)
 		if chunkIndex > 0 {
 			omc.addPartialChunkMetadata(&chunk, section.Title, chunkIndex)
 		}
@@ -607,3 +685,60 @@ func (omc *OptimizedMarkdownChunker) postProcessChunks(chunks []Chunk) []Chunk {
 	}
 	return chunks
 }
+
+// locateChunks converts chunk spans from byte offsets in source to
+// character offsets in the text without page markers, records the pages
+// each span covers and removes page markers that ended up in chunk content,
+// for example inside code blocks that cross a page boundary.
+func (omc *OptimizedMarkdownChunker) locateChunks(chunks []Chunk, source string) {
+	marks := parsers.FindPageMarkers(source)
+	starts := runeCounter{source: source, marks: marks}
+	ends := runeCounter{source: source, marks: marks}
+	for i := range chunks {
+		chunk := &chunks[i]
+		if len(marks) > 0 {
+			chunk.PageStart = pageAt(marks, chunk.StartIndex)
+			chunk.PageEnd = max(pageAt(marks, chunk.EndIndex-1), chunk.PageStart)
+		}
+		chunk.StartIndex = starts.offset(chunk.StartIndex)
+		chunk.EndIndex = ends.offset(chunk.EndIndex)
+		chunk.Content = parsers.StripPageMarkers(chunk.Content)
+	}
+}
+
+// pageAt returns the page of the last marker at or before the byte offset;
+// text before the first marker belongs to the first marked page.
+func pageAt(marks []parsers.PageMark, offset int) int {
+	i := sort.Search(len(marks), func(i int) bool { return marks[i].Start > offset })
+	if i == 0 {
+		return marks[0].Page
+	}
+	return marks[i-1].Page
+}
+
+// runeCounter converts byte offsets into character offsets that skip page
+// markers. It resumes from the previous offset, so mostly increasing
+// lookups stay linear in the text length.
+type runeCounter struct {
+	source     string
+	marks      []parsers.PageMark
+	byteOffset int
+	runeOffset int
+	markIndex  int
+}
+
+func (rc *runeCounter) offset(b int) int {
+	b = min(max(b, 0), len(rc.source))
+	if b < rc.byteOffset {
+		rc.byteOffset, rc.runeOffset, rc.markIndex = 0, 0, 0
+	}
+	rc.runeOffset += utf8.RuneCountInString(rc.source[rc.byteOffset:b])
+	rc.byteOffset = b
+	for rc.markIndex < len(rc.marks) && rc.marks[rc.markIndex].End <= b {
+		// Markers are ASCII, so their byte length is their rune count.
+		mark := rc.marks[rc.markIndex]
+		rc.runeOffset -= mark.End - mark.Start
+		rc.markIndex++
+	}
+	return rc.runeOffset
+}
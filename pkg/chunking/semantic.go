@@ -25,7 +25,7 @@ type SemanticChunker struct {
 	cfg      Config
 	embedder embedding.Embedder
 	base     *OptimizedMarkdownChunker
-	cache    *embeddingCache
+	cache    embedding.Cache
 }
 
 // Config defines chunking configuration.
@@ -40,8 +40,21 @@ type Config struct {
 	SimilarityThreshold float64
 	MaxMergeChunks      int
 	EnableParallel      bool
+	BatchSize           int
+	MaxBatchTokens      int
+	// Dimensions is the vector size requested from the model; zero keeps
+	// its native size.
+	Dimensions int
+	// Cache stores chunk embeddings across ChunkText calls. It must be
+	// keyed by Model and Dimensions; nil uses an in-memory cache owned by
+	// the chunker.
+	Cache embedding.Cache
 }
 
+// parallelBatches is how many embedding batches run concurrently when
+// parallel processing is enabled.
+const parallelBatches = 4
+
 // Option configures a SemanticChunker.
 type Option func(*Config)
 
@@ -52,6 +65,21 @@ func WithModel(model string) Option {
 	}
 }
 
+// WithDimensions sets the vector size requested from the embedding model.
+func WithDimensions(n int) Option {
+	return func(c *Config) {
+		c.Dimensions = n
+	}
+}
+
+// WithCache sets a shared embedding cache, such as the one ingestion
+// uses, so re-chunking a document does not re-embed its chunks.
+func WithCache(cache embedding.Cache) Option {
+	return func(c *Config) {
+		c.Cache = cache
+	}
+}
+
 // WithSimilarityThreshold sets the similarity threshold for merging.
 func WithSimilarityThreshold(threshold float64) Option {
 	return func(c *Config) {
@@ -59,7 +87,22 @@ func WithSimilarityThreshold(threshold float64) Option {
 	}
 }
 
-// WithParallelProcessing enables parallel embedding generation.
+// WithBatchSize sets how many chunks are embedded per request.
+func WithBatchSize(n int) Option {
+	return func(c *Config) {
+		c.BatchSize = n
+	}
+}
+
+// WithMaxBatchTokens sets the estimated token budget per embedding request.
+// Zero derives it from the model's input limit.
+func WithMaxBatchTokens(n int) Option {
+	return func(c *Config) {
+		c.MaxBatchTokens = n
+	}
+}
+
+// WithParallelProcessing enables concurrent embedding batches.
 func WithParallelProcessing(enabled bool) Option {
 	return func(c *Config) {
 		c.EnableParallel = enabled
@@ -97,6 +140,7 @@ func NewSemanticChunker(
 		SimilarityThreshold: 0.75,
 		MaxMergeChunks:      3,
 		EnableParallel:      true,
+		BatchSize:           embedding.DefaultBatchSize,
 	}
 
 	for _, opt := range opts {
@@ -119,11 +163,16 @@ func NewSemanticChunker(
 		return nil, fmt.Errorf("create base chunker: %w", err)
 	}
 
+	var cache embedding.Cache = newEmbeddingCache(1000)
+	if cfg.Cache != nil {
+		cache = cfg.Cache
+	}
+
 	return &SemanticChunker{
 		cfg:      cfg,
 		embedder: embedder,
 		base:     base,
-		cache:    newEmbeddingCache(1000),
+		cache:    cache,
 	}, nil
 }
 
@@ -156,100 +205,29 @@ func (sc *SemanticChunker) ChunkText(ctx context.Context, text string) ([]Chunk,
 	return sc.postProcess(merged), nil
 }
 
-// generateEmbeddings creates embeddings for all chunks.
+// generateEmbeddings creates embeddings for all chunks in batched requests.
 func (sc *SemanticChunker) generateEmbeddings(ctx context.Context, chunks []Chunk) ([][]float32, error) {
-	if !sc.cfg.EnableParallel {
-		return sc.sequentialEmbeddings(ctx, chunks)
-	}
-	return sc.parallelEmbeddings(ctx, chunks)
-}
-
-// sequentialEmbeddings generates embeddings one by one.
-func (sc *SemanticChunker) sequentialEmbeddings(ctx context.Context, chunks []Chunk) ([][]float32, error) {
-	embeddings := make([][]float32, len(chunks))
-
-	for i, chunk := range chunks {
-		embed, err := sc.getEmbedding(ctx, chunk.Content)
-		if err != nil {
-			return nil, fmt.Errorf("chunk %d: %w", i, err)
-		}
-		embeddings[i] = embed
-	}
-
-	return embeddings, nil
-}
-
-// parallelEmbeddings generates embeddings concurrently.
-func (sc *SemanticChunker) parallelEmbeddings(ctx context.Context, chunks []Chunk) ([][]float32, error) {
-	const maxWorkers = 5
-
-	type result struct {
-		idx   int
-		embed []float32
-		err   error
-	}
-
-	// Use buffered channel for results.
-	results := make(chan result, len(chunks))
-
-	// Create worker pool using WaitGroup.Go (Go 1.23+).
-	var wg sync.WaitGroup
-	sem := make(chan struct{}, maxWorkers)
-
+	texts := make([]string, len(chunks))
 	for i, chunk := range chunks {
-		idx, content := i, chunk.Content // Capture loop variables
-		wg.Go(func() {
-			// Acquire semaphore.
-			sem <- struct{}{}
-			defer func() { <-sem }()
-
-			embed, err := sc.getEmbedding(ctx, content)
-			results <- result{idx: idx, embed: embed, err: err}
-		})
-	}
-
-	// Wait for all workers and close results.
-	go func() {
-		wg.Wait()
-		close(results)
-	}()
-
-	// Collect results.
-	embeddings := make([][]float32, len(chunks))
-	for res := range results {
-		if res.err != nil {
-			return nil, fmt.Errorf("chunk %d: %w", res.idx, res.err)
-		}
-		embeddings[res.idx] = res.embed
+		texts[i] = chunk.Content
 	}
 
-	return embeddings, nil
-}
-
-// getEmbedding retrieves or generates an embedding.
-func (sc *SemanticChunker) getEmbedding(ctx context.Context, text string) ([]float32, error) {
-	// Check cache first.
-	if cached := sc.cache.get(text); cached != nil {
-		return cached, nil
+	concurrency := 1
+	if sc.cfg.EnableParallel {
+		concurrency = parallelBatches
 	}
 
-	resp, err := sc.embedder.CreateEmbeddingWithDefaults(sc.cfg.Model, text)
+	embeddings, err := embedding.BatchEmbed(ctx, sc.embedder, sc.cfg.Model, texts,
+		embedding.WithBatchSize(sc.cfg.BatchSize),
+		embedding.WithMaxBatchTokens(sc.cfg.MaxBatchTokens),
+		embedding.WithConcurrency(concurrency),
+		embedding.WithCache(sc.cache),
+		embedding.WithDimensions(sc.cfg.Dimensions),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("create embedding: %w", err)
-	}
-
-	if resp == nil || len(resp.Data) == 0 {
-		return nil, ErrNoEmbeddingData
+		return nil, fmt.Errorf("create embeddings: %w", err)
 	}
-
-	// Convert float64 to float32.
-	embed := make([]float32, len(resp.Data[0].Embedding))
-	for i, v := range resp.Data[0].Embedding {
-		embed[i] = float32(v)
-	}
-
-	sc.cache.set(text, embed)
-	return embed, nil
+	return embeddings, nil
 }
 
 // mergeBySemantics merges chunks based on semantic similarity.
@@ -309,7 +287,9 @@ func (sc *SemanticChunker) mergeChunks(chunks []Chunk, indices []int) Chunk {
 	}
 
 	// Update span.
-	merged.EndIndex = chunks[indices[len(indices)-1]].EndIndex
+	last := chunks[indices[len(indices)-1]]
+	merged.EndIndex = last.EndIndex
+	merged.PageEnd = max(merged.PageEnd, last.PageEnd)
 
 	// Add metadata.
 	if merged.Metadata == nil {
@@ -352,6 +332,9 @@ func (c *Config) validate() error {
 	if c.MaxMergeChunks <= 0 {
 		return fmt.Errorf("%w: max merge chunks must be positive", ErrInvalidConfig)
 	}
+	if c.BatchSize < 0 || c.MaxBatchTokens < 0 {
+		return fmt.Errorf("%w: batch size and token budget must not be negative", ErrInvalidConfig)
+	}
 	return nil
 }
 
@@ -399,6 +382,17 @@ func (c *embeddingCache) set(key string, value []float32) {
 	c.order = append(c.order, hashed)
 }
 
+// Get implements embedding.Cache.
+func (c *embeddingCache) Get(_ context.Context, text string) ([]float32, bool) {
+	value := c.get(text)
+	return value, value != nil
+}
+
+// Set implements embedding.Cache.
+func (c *embeddingCache) Set(_ context.Context, text string, value []float32) {
+	c.set(text, value)
+}
+
 // hashKey generates a cache key from text.
 func hashKey(text string) string {
 	const maxLen = 32
@@ -1,8 +1,11 @@
 package base
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -40,29 +43,49 @@ func NewHTTPError(service, op string, statusCode int, body string) *ClientError
 }
 
 type HTTPClient struct {
-	client  *resty.Client
-	service string
+	client     *resty.Client
+	service    string
+	timeout    time.Duration
+	resilience *resilience
 }
 
+// NewHTTPClient creates a client whose calls each get timeout as their
+// deadline, including retries; cfg.Timeout overrides it when set. Callers
+// can shorten it through ctx. Calls to the service are rate limited,
+// retried and guarded by a circuit breaker as configured in
+// cfg.Resilience.
 func NewHTTPClient(service string, cfg config.ServiceConfig, timeout time.Duration) *HTTPClient {
+	if cfg.Timeout > 0 {
+		timeout = cfg.Timeout
+	}
 	client := resty.New().
 		SetBaseURL(cfg.BaseURL).
 		SetHeader("Authorization", "Bearer "+cfg.APIKey).
-		SetHeader("Content-Type", "application/json").
-		SetTimeout(timeout).
-		SetRetryCount(3).
-		SetRetryWaitTime(1 * time.Second).
-		SetRetryMaxWaitTime(5 * time.Second)
+		SetHeader("Content-Type", "application/json")
 
-	client.AddRetryCondition(func(r *resty.Response, err error) bool { return err != nil || r.StatusCode() >= 500 })
+	h := &HTTPClient{client: client, service: service, timeout: timeout, resilience: newResilience(cfg.Resilience)}
+	h.publish()
+	return h
+}
 
-	return &HTTPClient{client: client, service: service}
+// callContext bounds ctx by the per-call timeout. A deadline already set
+// by the caller wins when it is earlier.
+func (h *HTTPClient) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if h.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, h.timeout)
 }
 
-func (h *HTTPClient) Post(endpoint string, body interface{}, result interface{}) error {
-	resp, err := h.client.R().SetBody(body).SetResult(result).Post(endpoint)
+func (h *HTTPClient) Post(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	ctx, cancel := h.callContext(ctx)
+	defer cancel()
+
+	resp, err := h.do(ctx, "POST "+endpoint, func(req *resty.Request) (*resty.Response, error) {
+		return req.SetBody(body).SetResult(result).Post(endpoint)
+	})
 	if err != nil {
-		return NewClientError(h.service, "POST "+endpoint, err)
+		return err
 	}
 	if resp.StatusCode() != 200 {
 		return NewHTTPError(h.service, "POST "+endpoint, resp.StatusCode(), resp.String())
@@ -70,14 +93,67 @@ func (h *HTTPClient) Post(endpoint string, body interface{}, result interface{})
 	return nil
 }
 
-func (h *HTTPClient) Get(endpoint string, params map[string]string, result interface{}) error {
-	req := h.client.R().SetResult(result)
-	for k, v := range params {
-		req.SetQueryParam(k, v)
+// PostStream sends a POST request and returns the unparsed response body so
+// callers can consume streamed payloads such as server-sent events. The
+// caller must close the returned body.
+//
+// The per-call timeout only covers waiting for the response headers; the
+// stream itself lives until ctx is done or the body is closed.
+func (h *HTTPClient) PostStream(ctx context.Context, endpoint string, body interface{}) (io.ReadCloser, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	var headerTimer *time.Timer
+	if h.timeout > 0 {
+		headerTimer = time.AfterFunc(h.timeout, cancel)
+	}
+
+	resp, err := h.do(ctx, "POST "+endpoint, func(req *resty.Request) (*resty.Response, error) {
+		return req.
+			SetBody(body).
+			SetHeader("Accept", "text/event-stream").
+			SetDoNotParseResponse(true).
+			Post(endpoint)
+	})
+	if headerTimer != nil && !headerTimer.Stop() {
+		// The timer fired, so the request was cancelled.
+		err = errors.Join(err, context.DeadlineExceeded)
 	}
-	resp, err := req.Get(endpoint)
 	if err != nil {
-		return NewClientError(h.service, "GET "+endpoint, err)
+		if resp != nil {
+			_ = resp.RawBody().Close()
+		}
+		cancel()
+		return nil, err
+	}
+	raw := resp.RawBody()
+	if resp.StatusCode() != 200 {
+		defer cancel()
+		defer raw.Close()
+		data, _ := io.ReadAll(raw)
+		return nil, NewHTTPError(h.service, "POST "+endpoint, resp.StatusCode(), string(data))
+	}
+	return &cancelOnClose{ReadCloser: raw, cancel: cancel}, nil
+}
+
+// cancelOnClose releases the stream's context together with its body.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+func (h *HTTPClient) Get(ctx context.Context, endpoint string, params map[string]string, result interface{}) error {
+	ctx, cancel := h.callContext(ctx)
+	defer cancel()
+
+	resp, err := h.do(ctx, "GET "+endpoint, func(req *resty.Request) (*resty.Response, error) {
+		return req.SetResult(result).SetQueryParams(params).Get(endpoint)
+	})
+	if err != nil {
+		return err
 	}
 	if resp.StatusCode() != 200 {
 		return NewHTTPError(h.service, "GET "+endpoint, resp.StatusCode(), resp.String())
@@ -85,8 +161,11 @@ func (h *HTTPClient) Get(endpoint string, params map[string]string, result inter
 	return nil
 }
 
-func (h *HTTPClient) Put(url string, body interface{}) error {
-	resp, err := resty.New().R().SetBody(body).Put(url)
+func (h *HTTPClient) Put(ctx context.Context, url string, body interface{}) error {
+	ctx, cancel := h.callContext(ctx)
+	defer cancel()
+
+	resp, err := resty.New().R().SetContext(ctx).SetBody(body).Put(url)
 	if err != nil {
 		return NewClientError(h.service, "PUT", err)
 	}
@@ -96,8 +175,11 @@ func (h *HTTPClient) Put(url string, body interface{}) error {
 	return nil
 }
 
-func (h *HTTPClient) GetRaw(url string) ([]byte, error) {
-	resp, err := resty.New().R().Get(url)
+func (h *HTTPClient) GetRaw(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := h.callContext(ctx)
+	defer cancel()
+
+	resp, err := resty.New().R().SetContext(ctx).Get(url)
 	if err != nil {
 		return nil, NewClientError(h.service, "GET raw", err)
 	}
@@ -108,9 +190,14 @@ func (h *HTTPClient) GetRaw(url string) ([]byte, error) {
 }
 
 func IsRetryableError(err error) bool {
+	// A cancelled or expired context fails every retry the same way, and an
+	// open breaker rejects calls until its timeout elapses.
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrCircuitOpen) {
+		return false
+	}
 	var clientErr *ClientError
 	if !errors.As(err, &clientErr) {
 		return false
 	}
-	return clientErr.StatusCode >= 500 || clientErr.StatusCode == 0
+	return clientErr.StatusCode >= 500 || clientErr.StatusCode == http.StatusTooManyRequests || clientErr.StatusCode == 0
 }
@@ -0,0 +1,409 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hsn0918/rag/pkg/config"
+)
+
+const (
+	DefaultMaxRetries       = 3
+	DefaultFailureThreshold = 5
+	DefaultOpenTimeout      = 30 * time.Second
+
+	retryBaseWait = 1 * time.Second
+	retryMaxWait  = 5 * time.Second
+	// maxRetryAfter caps how long a Retry-After header may delay a retry;
+	// longer waits fail the call instead.
+	maxRetryAfter = 30 * time.Second
+)
+
+var (
+	// ErrCircuitOpen is returned without calling the service while its
+	// circuit breaker is open.
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+	// ErrRateLimited is returned when the client-side rate limit cannot
+	// admit a call before the caller's deadline.
+	ErrRateLimited = errors.New("rate limit exceeded")
+)
+
+// BreakerState is the state of a client's circuit breaker.
+type BreakerState string
+
+const (
+	// BreakerClosed lets every call through.
+	BreakerClosed BreakerState = "closed"
+	// BreakerOpen rejects calls until the open timeout elapses.
+	BreakerOpen BreakerState = "open"
+	// BreakerHalfOpen lets a single probe through to test the service.
+	BreakerHalfOpen BreakerState = "half_open"
+)
+
+// Stats is a snapshot of a client's resilience state. The stats of every
+// client are published through expvar under "external_services".
+type Stats struct {
+	Service             string       `json:"service"`
+	BreakerState        BreakerState `json:"breaker_state"`
+	ConsecutiveFailures int          `json:"consecutive_failures"`
+	// BreakerTrips counts transitions to open.
+	BreakerTrips int64 `json:"breaker_trips"`
+	// Rejected counts calls refused by the open breaker.
+	Rejected int64 `json:"rejected"`
+	Retries  int64 `json:"retries"`
+	// RateLimited counts 429 responses from the service.
+	RateLimited int64 `json:"rate_limited"`
+	// Throttled counts calls delayed by the client-side rate limit.
+	Throttled int64 `json:"throttled"`
+}
+
+var servicesVar = expvar.NewMap("external_services")
+
+// outcome classifies an attempt for the circuit breaker.
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota
+	outcomeFailure
+	// outcomeIgnored leaves the breaker unchanged, e.g. when the caller
+	// gave up or the service is only throttling.
+	outcomeIgnored
+)
+
+// circuitBreaker opens after threshold consecutive failures and lets one
+// probe through once openTimeout has elapsed.
+type circuitBreaker struct {
+	threshold   int
+	openTimeout time.Duration
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+
+	trips    atomic.Int64
+	rejected atomic.Int64
+}
+
+func newCircuitBreaker(threshold int, openTimeout time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, openTimeout: openTimeout, state: BreakerClosed}
+}
+
+// currentState moves an expired open breaker to half-open. b.mu must be held.
+func (b *circuitBreaker) currentState(now time.Time) BreakerState {
+	if b.state == BreakerOpen && now.Sub(b.openedAt) >= b.openTimeout {
+		b.state = BreakerHalfOpen
+	}
+	return b.state
+}
+
+// allow reports whether a call may proceed. Every allowed call must be
+// followed by done.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.currentState(time.Now()) {
+	case BreakerOpen:
+		b.rejected.Add(1)
+		return ErrCircuitOpen
+	case BreakerHalfOpen:
+		if b.probing {
+			b.rejected.Add(1)
+			return ErrCircuitOpen
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+func (b *circuitBreaker) done(result outcome) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	halfOpen := b.state == BreakerHalfOpen
+	if halfOpen {
+		b.probing = false
+	}
+	switch result {
+	case outcomeSuccess:
+		b.state = BreakerClosed
+		b.failures = 0
+	case outcomeFailure:
+		b.failures++
+		if halfOpen || (b.state == BreakerClosed && b.failures >= b.threshold) {
+			b.state = BreakerOpen
+			b.openedAt = time.Now()
+			b.trips.Add(1)
+		}
+	}
+}
+
+// admits reports whether allow would let a call through right now, without
+// claiming the half-open probe.
+func (b *circuitBreaker) admits() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.currentState(time.Now()) {
+	case BreakerOpen:
+		return false
+	case BreakerHalfOpen:
+		return !b.probing
+	}
+	return true
+}
+
+func (b *circuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.currentState(time.Now())
+}
+
+// rateLimiter is a token bucket. Callers reserve a token up front and wait
+// until it is due, so waiting callers are served in order.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+
+	throttled atomic.Int64
+}
+
+// newRateLimiter returns nil, which never limits, when rate is not positive.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = max(int(rate), 1)
+	}
+	return &rateLimiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+	if delay == 0 {
+		return nil
+	}
+
+	l.throttled.Add(1)
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		l.refund()
+		return ErrRateLimited
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.refund()
+		return ctx.Err()
+	}
+}
+
+func (l *rateLimiter) refund() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.burst, l.tokens+1)
+}
+
+// resilience holds the per-client policies applied by HTTPClient.do.
+type resilience struct {
+	maxRetries int
+	breaker    *circuitBreaker
+	limiter    *rateLimiter
+
+	retries     atomic.Int64
+	rateLimited atomic.Int64
+}
+
+func newResilience(cfg config.ResilienceConfig) *resilience {
+	r := &resilience{
+		maxRetries: DefaultMaxRetries,
+		limiter:    newRateLimiter(cfg.RateLimit, cfg.Burst),
+	}
+	if cfg.MaxRetries != nil {
+		r.maxRetries = *cfg.MaxRetries
+	}
+	threshold := cfg.FailureThreshold
+	if threshold == 0 {
+		threshold = DefaultFailureThreshold
+	}
+	openTimeout := cfg.OpenTimeout
+	if openTimeout == 0 {
+		openTimeout = DefaultOpenTimeout
+	}
+	r.breaker = newCircuitBreaker(threshold, openTimeout)
+	return r
+}
+
+// classify maps an attempt to its breaker outcome and whether it may be
+// retried.
+func classify(ctx context.Context, resp *resty.Response, err error) (outcome, bool) {
+	switch {
+	case ctx.Err() != nil:
+		return outcomeIgnored, false
+	case err != nil:
+		return outcomeFailure, true
+	case resp.StatusCode() == http.StatusTooManyRequests:
+		return outcomeIgnored, true
+	case resp.StatusCode() >= 500:
+		return outcomeFailure, true
+	default:
+		// Other statuses are answers from a healthy service.
+		return outcomeSuccess, false
+	}
+}
+
+// retryDelay honours Retry-After on 429 and 503 responses and otherwise
+// backs off exponentially with jitter.
+func retryDelay(resp *resty.Response, attempt int) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header().Get("Retry-After")); ok {
+			return d
+		}
+	}
+	backoff := min(retryBaseWait<<attempt, retryMaxWait)
+	// Equal jitter keeps at least half the backoff while spreading out
+	// clients that failed together.
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// do runs send under the rate limit and circuit breaker, retrying transport
+// errors, 429 and 5xx responses. send is called once per attempt with a new
+// request bound to ctx. The last response is returned even when its status
+// is an error, so callers can report it.
+func (h *HTTPClient) do(ctx context.Context, op string, send func(req *resty.Request) (*resty.Response, error)) (*resty.Response, error) {
+	r := h.resilience
+	for attempt := 0; ; attempt++ {
+		if err := r.limiter.wait(ctx); err != nil {
+			return nil, NewClientError(h.service, op, err)
+		}
+		if err := r.breaker.allow(); err != nil {
+			return nil, NewClientError(h.service, op, err)
+		}
+		resp, err := send(h.client.R().SetContext(ctx))
+		result, retryable := classify(ctx, resp, err)
+		r.breaker.done(result)
+		if err == nil && resp.StatusCode() == http.StatusTooManyRequests {
+			r.rateLimited.Add(1)
+		}
+		// Once the breaker opens, further retries would only be rejected.
+		if !retryable || attempt >= r.maxRetries || r.breaker.State() == BreakerOpen {
+			if err != nil {
+				return nil, NewClientError(h.service, op, err)
+			}
+			return resp, nil
+		}
+
+		delay := retryDelay(resp, attempt)
+		if delay > maxRetryAfter {
+			return resp, nil
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			// The retry could not finish in time anyway.
+			if err != nil {
+				return nil, NewClientError(h.service, op, err)
+			}
+			return resp, nil
+		}
+		if resp != nil {
+			// Release the connection of a streamed response before retrying.
+			if body := resp.RawBody(); body != nil {
+				_ = body.Close()
+			}
+		}
+		r.retries.Add(1)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, NewClientError(h.service, op, ctx.Err())
+		}
+	}
+}
+
+// Stats returns a snapshot of the client's resilience state.
+func (h *HTTPClient) Stats() Stats {
+	r := h.resilience
+	b := r.breaker
+	b.mu.Lock()
+	state := b.currentState(time.Now())
+	failures := b.failures
+	b.mu.Unlock()
+
+	stats := Stats{
+		Service:             h.service,
+		BreakerState:        state,
+		ConsecutiveFailures: failures,
+		BreakerTrips:        b.trips.Load(),
+		Rejected:            b.rejected.Load(),
+		Retries:             r.retries.Load(),
+		RateLimited:         r.rateLimited.Load(),
+	}
+	if r.limiter != nil {
+		stats.Throttled = r.limiter.throttled.Load()
+	}
+	return stats
+}
+
+// Available reports whether the circuit breaker currently admits calls. It
+// is false while the breaker is open and while a half-open probe is in
+// flight. Callers with a cheap local fallback can use it to skip the
+// service.
+func (h *HTTPClient) Available() bool {
+	return h.resilience.breaker.admits()
+}
+
+// StatsHandler serves the stats of every client as a JSON object keyed by
+// service name.
+func StatsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = w.Write([]byte(servicesVar.String()))
+	})
+}
+
+// publish exposes the client's stats through expvar. A later client for the
+// same service replaces the earlier one.
+func (h *HTTPClient) publish() {
+	servicesVar.Set(h.service, expvar.Func(func() any { return h.Stats() }))
+}
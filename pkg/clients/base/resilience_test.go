@@ -0,0 +1,164 @@
+package base
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hsn0918/rag/pkg/config"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	const openTimeout = time.Minute
+
+	// Steps drive a breaker with threshold 2: "ok" and "fail" run an
+	// allowed call with that outcome, "ignore" one the breaker must not
+	// count, "expire" lets the open timeout elapse and "reject" expects
+	// the next call to be refused.
+	tests := []struct {
+		name   string
+		steps  []string
+		state  BreakerState
+		trips  int64
+		admits bool
+	}{
+		{name: "closed below threshold", steps: []string{"fail"}, state: BreakerClosed, admits: true},
+		{name: "success resets failures", steps: []string{"fail", "ok", "fail"}, state: BreakerClosed, admits: true},
+		{name: "ignored outcomes do not count", steps: []string{"fail", "ignore", "ignore"}, state: BreakerClosed, admits: true},
+		{name: "opens at threshold", steps: []string{"fail", "fail", "reject"}, state: BreakerOpen, trips: 1},
+		{name: "half-open after timeout", steps: []string{"fail", "fail", "expire"}, state: BreakerHalfOpen, trips: 1, admits: true},
+		{name: "single probe while half-open", steps: []string{"fail", "fail", "expire", "probe", "reject"}, state: BreakerHalfOpen, trips: 1},
+		{name: "successful probe closes", steps: []string{"fail", "fail", "expire", "ok"}, state: BreakerClosed, trips: 1, admits: true},
+		{name: "failed probe reopens", steps: []string{"fail", "fail", "expire", "fail", "reject"}, state: BreakerOpen, trips: 2},
+		{name: "ignored probe stays half-open", steps: []string{"fail", "fail", "expire", "ignore", "ok"}, state: BreakerClosed, trips: 1, admits: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newCircuitBreaker(2, openTimeout)
+			for i, step := range tt.steps {
+				switch step {
+				case "expire":
+					b.mu.Lock()
+					b.openedAt = b.openedAt.Add(-openTimeout)
+					b.mu.Unlock()
+					continue
+				case "reject":
+					if err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+						t.Fatalf("step %d: allow() = %v, want ErrCircuitOpen", i, err)
+					}
+					continue
+				}
+				if err := b.allow(); err != nil {
+					t.Fatalf("step %d (%s): allow() = %v", i, step, err)
+				}
+				switch step {
+				case "ok":
+					b.done(outcomeSuccess)
+				case "fail":
+					b.done(outcomeFailure)
+				case "ignore":
+					b.done(outcomeIgnored)
+				}
+			}
+			if got := b.State(); got != tt.state {
+				t.Errorf("state = %s, want %s", got, tt.state)
+			}
+			if got := b.trips.Load(); got != tt.trips {
+				t.Errorf("trips = %d, want %d", got, tt.trips)
+			}
+			if got := b.admits(); got != tt.admits {
+				t.Errorf("admits() = %v, want %v", got, tt.admits)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{name: "empty", value: ""},
+		{name: "seconds", value: "7", want: 7 * time.Second, ok: true},
+		{name: "zero", value: "0", ok: true},
+		{name: "negative", value: "-3"},
+		{name: "fraction", value: "1.5"},
+		{name: "garbage", value: "soon"},
+		{name: "past date", value: "Sun, 06 Nov 1994 08:49:37 GMT", ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+
+	t.Run("future date", func(t *testing.T) {
+		value := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+		got, ok := parseRetryAfter(value)
+		if !ok || got <= 8*time.Second || got > 10*time.Second {
+			t.Errorf("parseRetryAfter(%q) = %v, %v, want about 10s", value, got, ok)
+		}
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	for attempt := range 6 {
+		backoff := min(retryBaseWait<<attempt, retryMaxWait)
+		for range 20 {
+			if d := retryDelay(nil, attempt); d < backoff/2 || d > backoff {
+				t.Fatalf("retryDelay(nil, %d) = %v, want within [%v, %v]", attempt, d, backoff/2, backoff)
+			}
+		}
+	}
+}
+
+func TestMaxRetries(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+
+	tests := []struct {
+		name       string
+		maxRetries *int
+		attempts   int64
+	}{
+		{name: "unset uses default", attempts: DefaultMaxRetries + 1},
+		{name: "zero disables retries", maxRetries: intPtr(0), attempts: 1},
+		{name: "explicit", maxRetries: intPtr(2), attempts: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer srv.Close()
+
+			client := NewHTTPClient("test-"+tt.name, config.ServiceConfig{
+				BaseURL:    srv.URL,
+				Resilience: config.ResilienceConfig{MaxRetries: tt.maxRetries, FailureThreshold: 10},
+			}, 5*time.Second)
+			err := client.Post(context.Background(), "/", map[string]string{}, nil)
+
+			var clientErr *ClientError
+			if !errors.As(err, &clientErr) || clientErr.StatusCode != http.StatusServiceUnavailable {
+				t.Fatalf("err = %v, want HTTP 503", err)
+			}
+			if got := calls.Load(); got != tt.attempts {
+				t.Errorf("attempts = %d, want %d", got, tt.attempts)
+			}
+		})
+	}
+}
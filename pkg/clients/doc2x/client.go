@@ -1,6 +1,8 @@
 package doc2x
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -12,32 +14,55 @@ import (
 const serviceName = "doc2x"
 
 const (
-	DefaultTimeout    = 30 * time.Second
+	DefaultTimeout = 30 * time.Second
+	// ProcessingTimeout is the default for how long WaitForParsing and
+	// WaitForConversion poll before giving up.
 	ProcessingTimeout = 5 * time.Minute
 )
 
+// ErrProcessingTimeout is returned when a task is still processing after
+// the client's maximum wait.
+var ErrProcessingTimeout = errors.New("doc2x task did not finish in time")
+
 type DocumentParser interface {
-	UploadPDF(pdfData []byte) (*UploadResponse, error)
-	PreUpload() (*PreUploadResponse, error)
-	UploadToPresignedURL(url string, fileData []byte) error
-	GetStatus(uid string) (*StatusResponse, error)
-	ConvertParse(req ConvertRequest) (*ConvertResponse, error)
-	GetConvertResult(uid string) (*ConvertResultResponse, error)
-	DownloadFile(url string) ([]byte, error)
-	WaitForParsing(uid string, pollInterval time.Duration) (*StatusResponse, error)
-	WaitForConversion(uid string, pollInterval time.Duration) (*ConvertResultResponse, error)
+	UploadPDF(ctx context.Context, pdfData []byte) (*UploadResponse, error)
+	PreUpload(ctx context.Context) (*PreUploadResponse, error)
+	UploadToPresignedURL(ctx context.Context, url string, fileData []byte) error
+	GetStatus(ctx context.Context, uid string) (*StatusResponse, error)
+	ConvertParse(ctx context.Context, req ConvertRequest) (*ConvertResponse, error)
+	GetConvertResult(ctx context.Context, uid string) (*ConvertResultResponse, error)
+	DownloadFile(ctx context.Context, url string) ([]byte, error)
+	WaitForParsing(ctx context.Context, uid string, pollInterval time.Duration) (*StatusResponse, error)
+	WaitForConversion(ctx context.Context, uid string, pollInterval time.Duration) (*ConvertResultResponse, error)
 }
 
 type Client struct {
 	httpClient *base.HTTPClient
 	cfg        config.ServiceConfig
+	maxWait    time.Duration
 }
 
 var _ DocumentParser = (*Client)(nil)
 
-func NewClient(cfg config.ServiceConfig) *Client {
+// Option configures a Client.
+type Option func(*Client)
+
+// WithMaxWait bounds how long the Wait methods poll a task.
+func WithMaxWait(d time.Duration) Option {
+	return func(c *Client) {
+		if d > 0 {
+			c.maxWait = d
+		}
+	}
+}
+
+func NewClient(cfg config.ServiceConfig, opts ...Option) *Client {
 	httpClient := base.NewHTTPClient(serviceName, cfg, DefaultTimeout)
-	return &Client{httpClient: httpClient, cfg: cfg}
+	c := &Client{httpClient: httpClient, cfg: cfg, maxWait: ProcessingTimeout}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 type UploadResponse struct {
@@ -94,91 +119,137 @@ type ConvertResultResponse struct {
 	} `json:"data"`
 }
 
-func (c *Client) UploadPDF(pdfData []byte) (*UploadResponse, error) {
+func (c *Client) UploadPDF(ctx context.Context, pdfData []byte) (*UploadResponse, error) {
 	var result UploadResponse
-	if err := c.httpClient.Post("/api/v2/parse/pdf", pdfData, &result); err != nil {
+	if err := c.httpClient.Post(ctx, "/api/v2/parse/pdf", pdfData, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
-func (c *Client) PreUpload() (*PreUploadResponse, error) {
+func (c *Client) PreUpload(ctx context.Context) (*PreUploadResponse, error) {
 	var result PreUploadResponse
-	if err := c.httpClient.Post("/api/v2/parse/preupload", nil, &result); err != nil {
+	if err := c.httpClient.Post(ctx, "/api/v2/parse/preupload", nil, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
-func (c *Client) UploadToPresignedURL(url string, fileData []byte) error {
-	return c.httpClient.Put(url, fileData)
+func (c *Client) UploadToPresignedURL(ctx context.Context, url string, fileData []byte) error {
+	return c.httpClient.Put(ctx, url, fileData)
 }
-func (c *Client) GetStatus(uid string) (*StatusResponse, error) {
+func (c *Client) GetStatus(ctx context.Context, uid string) (*StatusResponse, error) {
 	var result StatusResponse
 	params := map[string]string{"uid": uid}
-	if err := c.httpClient.Get("/api/v2/parse/status", params, &result); err != nil {
+	if err := c.httpClient.Get(ctx, "/api/v2/parse/status", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
-func (c *Client) ConvertParse(req ConvertRequest) (*ConvertResponse, error) {
+func (c *Client) ConvertParse(ctx context.Context, req ConvertRequest) (*ConvertResponse, error) {
 	var result ConvertResponse
-	if err := c.httpClient.Post("/api/v2/convert/parse", req, &result); err != nil {
+	if err := c.httpClient.Post(ctx, "/api/v2/convert/parse", req, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
-func (c *Client) GetConvertResult(uid string) (*ConvertResultResponse, error) {
+func (c *Client) GetConvertResult(ctx context.Context, uid string) (*ConvertResultResponse, error) {
 	var result ConvertResultResponse
 	params := map[string]string{"uid": uid}
-	if err := c.httpClient.Get("/api/v2/convert/parse/result", params, &result); err != nil {
+	if err := c.httpClient.Get(ctx, "/api/v2/convert/parse/result", params, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
-func (c *Client) DownloadFile(url string) ([]byte, error) {
+func (c *Client) DownloadFile(ctx context.Context, url string) ([]byte, error) {
 	url = strings.ReplaceAll(url, "\\u0026", "&")
-	return c.httpClient.GetRaw(url)
+	return c.httpClient.GetRaw(ctx, url)
 }
 
-func (c *Client) WaitForParsing(uid string, pollInterval time.Duration) (*StatusResponse, error) {
-	for {
-		status, err := c.GetStatus(uid)
+func (c *Client) WaitForParsing(ctx context.Context, uid string, pollInterval time.Duration) (*StatusResponse, error) {
+	return c.WaitForParsingWithProgress(ctx, uid, pollInterval, nil)
+}
+
+// WaitForParsingWithProgress polls like WaitForParsing and reports the
+// parse progress (0-100) to onProgress after every poll.
+func (c *Client) WaitForParsingWithProgress(ctx context.Context, uid string, pollInterval time.Duration, onProgress func(progress int)) (*StatusResponse, error) {
+	var status *StatusResponse
+	err := c.poll(ctx, "wait for parsing", pollInterval, func(ctx context.Context) (bool, error) {
+		var err error
+		status, err = c.GetStatus(ctx, uid)
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 		if status.Code != "success" {
-			return nil, base.NewClientError(serviceName, "wait for parsing", fmt.Errorf("parse failed: %s - %s", status.Code, status.Msg))
+			return false, base.NewClientError(serviceName, "wait for parsing", fmt.Errorf("parse failed: %s - %s", status.Code, status.Msg))
+		}
+		if onProgress != nil {
+			onProgress(status.Data.Progress)
 		}
 		switch status.Data.Status {
 		case "success":
-			return status, nil
+			return true, nil
 		case "failed":
-			return nil, base.NewClientError(serviceName, "wait for parsing", fmt.Errorf("parse failed: %s", status.Data.Detail))
-		case "processing":
-			time.Sleep(pollInterval)
+			return false, base.NewClientError(serviceName, "wait for parsing", fmt.Errorf("parse failed: %s", status.Data.Detail))
 		default:
-			time.Sleep(pollInterval)
+			return false, nil
 		}
+	})
+	if err != nil {
+		return nil, err
 	}
+	return status, nil
 }
 
-func (c *Client) WaitForConversion(uid string, pollInterval time.Duration) (*ConvertResultResponse, error) {
-	for {
-		result, err := c.GetConvertResult(uid)
+func (c *Client) WaitForConversion(ctx context.Context, uid string, pollInterval time.Duration) (*ConvertResultResponse, error) {
+	var result *ConvertResultResponse
+	err := c.poll(ctx, "wait for conversion", pollInterval, func(ctx context.Context) (bool, error) {
+		var err error
+		result, err = c.GetConvertResult(ctx, uid)
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 		if result.Code != "success" {
-			return nil, base.NewClientError(serviceName, "wait for conversion", fmt.Errorf("convert failed: %s", result.Code))
+			return false, base.NewClientError(serviceName, "wait for conversion", fmt.Errorf("convert failed: %s", result.Code))
 		}
 		switch result.Data.Status {
 		case "success":
-			return result, nil
+			return true, nil
 		case "failed":
-			return nil, base.NewClientError(serviceName, "wait for conversion", fmt.Errorf("convert failed"))
-		case "processing":
-			time.Sleep(pollInterval)
+			return false, base.NewClientError(serviceName, "wait for conversion", fmt.Errorf("convert failed"))
 		default:
-			time.Sleep(pollInterval)
+			return false, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// poll calls check every pollInterval until it reports done or fails, ctx
+// is done, or the client's maximum wait elapses.
+func (c *Client) poll(ctx context.Context, op string, pollInterval time.Duration, check func(ctx context.Context) (bool, error)) error {
+	waitCtx, cancel := context.WithTimeout(ctx, c.maxWait)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		done, err := check(waitCtx)
+		if done {
+			return nil
+		}
+		if err == nil {
+			select {
+			case <-waitCtx.Done():
+				err = waitCtx.Err()
+			case <-ticker.C:
+				continue
+			}
+		}
+		// The wait ran out while the caller's context is still live.
+		if waitCtx.Err() != nil && ctx.Err() == nil {
+			return base.NewClientError(serviceName, op, fmt.Errorf("%w after %s", ErrProcessingTimeout, c.maxWait))
 		}
+		return err
 	}
 }
@@ -0,0 +1,272 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"unicode"
+)
+
+const (
+	DefaultBatchSize = 32
+	// DefaultBatchTokenFactor sizes the default per-request token budget as
+	// a multiple of the model's per-input limit (GetMaxTokens).
+	DefaultBatchTokenFactor = 8
+)
+
+var ErrMissingEmbedding = errors.New("embedding response is missing an input")
+
+// Cache stores embeddings per input text so repeated texts skip the API.
+type Cache interface {
+	Get(ctx context.Context, text string) ([]float32, bool)
+	Set(ctx context.Context, text string, embedding []float32)
+}
+
+// BatchError reports the inputs whose batch failed. Embeddings for all other
+// inputs are still returned alongside it.
+type BatchError struct {
+	Failed []int
+	Err    error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("%d inputs failed to embed: %v", len(e.Failed), e.Err)
+}
+
+func (e *BatchError) Unwrap() error { return e.Err }
+
+type batchOptions struct {
+	batchSize   int
+	maxTokens   int
+	concurrency int
+	cache       Cache
+	onProgress  func(embedded, failed int)
+	dimensions  int
+}
+
+// BatchOption configures BatchEmbed.
+type BatchOption func(*batchOptions)
+
+// WithBatchSize caps the number of inputs sent in one request.
+func WithBatchSize(n int) BatchOption {
+	return func(o *batchOptions) {
+		if n > 0 {
+			o.batchSize = n
+		}
+	}
+}
+
+// WithMaxBatchTokens caps the estimated tokens sent in one request.
+func WithMaxBatchTokens(n int) BatchOption {
+	return func(o *batchOptions) {
+		if n > 0 {
+			o.maxTokens = n
+		}
+	}
+}
+
+// WithConcurrency sets how many batch requests may be in flight at once.
+func WithConcurrency(n int) BatchOption {
+	return func(o *batchOptions) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// WithCache looks inputs up in cache before calling the API and stores the
+// new embeddings in it afterwards.
+func WithCache(cache Cache) BatchOption {
+	return func(o *batchOptions) {
+		o.cache = cache
+	}
+}
+
+// WithDimensions requests n-dimensional vectors from models that can
+// shorten their output (see RequestDimensions). Zero keeps the native size.
+func WithDimensions(n int) BatchOption {
+	return func(o *batchOptions) {
+		o.dimensions = n
+	}
+}
+
+// WithProgress is called after each batch with the number of inputs that
+// were embedded and failed in it. Cache hits are reported up front. Calls
+// are serialized.
+func WithProgress(fn func(embedded, failed int)) BatchOption {
+	return func(o *batchOptions) {
+		o.onProgress = fn
+	}
+}
+
+// BatchEmbed embeds texts with as few CreateBatchEmbedding calls as the batch
+// size and token budget allow. The result is index-aligned with texts.
+//
+// Results are matched to inputs by Data.Index, not response order. When
+// some batches fail, the returned error is a *BatchError and the failed
+// positions in the result are nil.
+func BatchEmbed(ctx context.Context, e Embedder, model string, texts []string, opts ...BatchOption) ([][]float32, error) {
+	o := batchOptions{
+		batchSize:   DefaultBatchSize,
+		maxTokens:   GetMaxTokens(model) * DefaultBatchTokenFactor,
+		concurrency: 1,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var progressMu sync.Mutex
+	report := func(embedded, failed int) {
+		if o.onProgress == nil {
+			return
+		}
+		progressMu.Lock()
+		defer progressMu.Unlock()
+		o.onProgress(embedded, failed)
+	}
+
+	results := make([][]float32, len(texts))
+
+	// Resolve cache hits and collapse duplicate texts into one input.
+	pending := make(map[string][]int)
+	var order []string
+	hits := 0
+	for i, text := range texts {
+		if o.cache != nil {
+			if cached, ok := o.cache.Get(ctx, text); ok && len(cached) > 0 {
+				results[i] = cached
+				hits++
+				continue
+			}
+		}
+		if _, seen := pending[text]; !seen {
+			order = append(order, text)
+		}
+		pending[text] = append(pending[text], i)
+	}
+	if hits > 0 {
+		report(hits, 0)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		failedMu sync.Mutex
+		failed   []int
+		firstErr error
+	)
+	sem := make(chan struct{}, o.concurrency)
+	for _, batch := range splitBatches(order, o.batchSize, o.maxTokens) {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			inputs := 0
+			for _, text := range batch {
+				inputs += len(pending[text])
+			}
+
+			vectors, err := embedBatch(ctx, e, model, o.dimensions, batch)
+			if err != nil {
+				failedMu.Lock()
+				for _, text := range batch {
+					failed = append(failed, pending[text]...)
+				}
+				if firstErr == nil {
+					firstErr = err
+				}
+				failedMu.Unlock()
+				report(0, inputs)
+				return
+			}
+
+			for j, text := range batch {
+				for _, idx := range pending[text] {
+					results[idx] = vectors[j]
+				}
+				if o.cache != nil {
+					o.cache.Set(ctx, text, vectors[j])
+				}
+			}
+			report(inputs, 0)
+		})
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		sort.Ints(failed)
+		return results, &BatchError{Failed: failed, Err: firstErr}
+	}
+	return results, nil
+}
+
+func embedBatch(ctx context.Context, e Embedder, model string, dimensions int, batch []string) ([][]float32, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := e.CreateEmbedding(ctx, Request{Model: model, Input: batch, EncodingFormat: "float", Dimensions: dimensions})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, ErrMissingEmbedding
+	}
+
+	vectors := make([][]float32, len(batch))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(batch) {
+			return nil, fmt.Errorf("embedding response index %d out of range for batch of %d", d.Index, len(batch))
+		}
+		vec := make([]float32, len(d.Embedding))
+		for i, v := range d.Embedding {
+			vec[i] = float32(v)
+		}
+		vectors[d.Index] = vec
+	}
+	for i, vec := range vectors {
+		if len(vec) == 0 {
+			return nil, fmt.Errorf("%w: index %d", ErrMissingEmbedding, i)
+		}
+	}
+	return vectors, nil
+}
+
+// splitBatches groups texts in order so that no batch exceeds batchSize
+// inputs or maxTokens estimated tokens. A single text over the budget is
+// sent on its own.
+func splitBatches(texts []string, batchSize, maxTokens int) [][]string {
+	var (
+		batches [][]string
+		current []string
+		tokens  int
+	)
+	for _, text := range texts {
+		n := EstimateTokens(text)
+		if len(current) > 0 && (len(current) >= batchSize || tokens+n > maxTokens) {
+			batches = append(batches, current)
+			current, tokens = nil, 0
+		}
+		current = append(current, text)
+		tokens += n
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
+
+// EstimateTokens approximates a text's token count: one per CJK character
+// and one per four other characters.
+func EstimateTokens(text string) int {
+	cjk, other := 0, 0
+	for _, r := range text {
+		if unicode.Is(unicode.Han, r) || unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			cjk++
+		} else {
+			other++
+		}
+	}
+	return cjk + (other+3)/4
+}
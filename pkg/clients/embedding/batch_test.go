@@ -0,0 +1,179 @@
+package embedding
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var errFakeBatch = errors.New("fake batch failure")
+
+// fakeEmbedder answers every batch in reverse order so callers must map
+// results by Data.Index, and fails any batch containing a text in fail.
+type fakeEmbedder struct {
+	fail map[string]bool
+
+	mu    sync.Mutex
+	calls [][]string
+}
+
+func (f *fakeEmbedder) CreateEmbedding(_ context.Context, req Request) (*Response, error) {
+	batch := req.Input.([]string)
+	f.mu.Lock()
+	f.calls = append(f.calls, batch)
+	f.mu.Unlock()
+
+	resp := &Response{Model: req.Model}
+	for i := len(batch) - 1; i >= 0; i-- {
+		if f.fail[batch[i]] {
+			return nil, errFakeBatch
+		}
+		resp.Data = append(resp.Data, Data{Index: i, Embedding: fakeVector(batch[i])})
+	}
+	return resp, nil
+}
+
+func (f *fakeEmbedder) CreateEmbeddingWithDefaults(ctx context.Context, model, text string) (*Response, error) {
+	return f.CreateEmbedding(ctx, Request{Model: model, Input: []string{text}})
+}
+
+func (f *fakeEmbedder) CreateBatchEmbedding(ctx context.Context, model string, texts []string) (*Response, error) {
+	return f.CreateEmbedding(ctx, Request{Model: model, Input: texts})
+}
+
+func fakeVector(text string) []float64 {
+	return []float64{float64(text[0]), float64(len(text))}
+}
+
+func fakeVector32(text string) []float32 {
+	v := fakeVector(text)
+	return []float32{float32(v[0]), float32(v[1])}
+}
+
+type mapCache struct {
+	mu      sync.Mutex
+	entries map[string][]float32
+}
+
+func (c *mapCache) Get(_ context.Context, text string) ([]float32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.entries[text]
+	return v, ok
+}
+
+func (c *mapCache) Set(_ context.Context, text string, embedding []float32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[text] = embedding
+}
+
+func TestBatchEmbed(t *testing.T) {
+	tests := []struct {
+		name   string
+		texts  []string
+		opts   []BatchOption
+		cached []string
+		fail   []string
+		calls  [][]string
+		failed []int
+	}{
+		{
+			name:  "out of order response mapped by index",
+			texts: []string{"a", "bb", "ccc"},
+			calls: [][]string{{"a", "bb", "ccc"}},
+		},
+		{
+			name:  "duplicate texts sent once",
+			texts: []string{"a", "b", "a", "a"},
+			calls: [][]string{{"a", "b"}},
+		},
+		{
+			name:   "cache hits skip the API",
+			texts:  []string{"a", "b", "c"},
+			cached: []string{"a", "c"},
+			calls:  [][]string{{"b"}},
+		},
+		{
+			name:   "all cached makes no call",
+			texts:  []string{"a", "b"},
+			cached: []string{"a", "b"},
+		},
+		{
+			name:  "split by batch size",
+			texts: []string{"a", "b", "c", "d", "e"},
+			opts:  []BatchOption{WithBatchSize(2)},
+			calls: [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		},
+		{
+			name:  "split by token budget",
+			texts: []string{"aaaa", "bbbbbbbb", "cccc", strings.Repeat("d", 20), "一二三", "e"},
+			opts:  []BatchOption{WithMaxBatchTokens(4)},
+			calls: [][]string{{"aaaa", "bbbbbbbb", "cccc"}, {strings.Repeat("d", 20)}, {"一二三", "e"}},
+		},
+		{
+			name:   "failed batch reported",
+			texts:  []string{"a", "b", "c", "d", "c"},
+			opts:   []BatchOption{WithBatchSize(2)},
+			fail:   []string{"c"},
+			calls:  [][]string{{"a", "b"}, {"c", "d"}},
+			failed: []int{2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &fakeEmbedder{fail: make(map[string]bool)}
+			for _, text := range tt.fail {
+				e.fail[text] = true
+			}
+			cache := &mapCache{entries: make(map[string][]float32)}
+			for _, text := range tt.cached {
+				cache.entries[text] = fakeVector32(text)
+			}
+			opts := append([]BatchOption{WithCache(cache), WithConcurrency(2)}, tt.opts...)
+
+			got, err := BatchEmbed(context.Background(), e, "test-model", tt.texts, opts...)
+			if tt.failed == nil && err != nil {
+				t.Fatal(err)
+			}
+			if tt.failed != nil {
+				var batchErr *BatchError
+				if !errors.As(err, &batchErr) || !errors.Is(err, errFakeBatch) {
+					t.Fatalf("err = %v, want a BatchError wrapping the batch failure", err)
+				}
+				if !reflect.DeepEqual(batchErr.Failed, tt.failed) {
+					t.Errorf("failed = %v, want %v", batchErr.Failed, tt.failed)
+				}
+			}
+
+			if len(got) != len(tt.texts) {
+				t.Fatalf("got %d results, want %d", len(got), len(tt.texts))
+			}
+			for i, text := range tt.texts {
+				if slices.Contains(tt.failed, i) {
+					if got[i] != nil {
+						t.Errorf("result %d = %v, want nil for a failed input", i, got[i])
+					}
+					continue
+				}
+				if want := fakeVector32(text); !reflect.DeepEqual(got[i], want) {
+					t.Errorf("result %d (%q) = %v, want %v", i, text, got[i], want)
+				}
+				if _, ok := cache.entries[text]; !ok {
+					t.Errorf("%q was not cached", text)
+				}
+			}
+
+			// Batches run concurrently, so compare them in a stable order.
+			slices.SortFunc(e.calls, func(a, b []string) int { return strings.Compare(a[0], b[0]) })
+			if !reflect.DeepEqual(e.calls, tt.calls) {
+				t.Errorf("calls = %q, want %q", e.calls, tt.calls)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package embedding
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/hsn0918/rag/pkg/clients/base"
@@ -13,9 +17,9 @@ const (
 )
 
 type Embedder interface {
-	CreateEmbedding(req Request) (*Response, error)
-	CreateEmbeddingWithDefaults(model, text string) (*Response, error)
-	CreateBatchEmbedding(model string, texts []string) (*Response, error)
+	CreateEmbedding(ctx context.Context, req Request) (*Response, error)
+	CreateEmbeddingWithDefaults(ctx context.Context, model, text string) (*Response, error)
+	CreateBatchEmbedding(ctx context.Context, model string, texts []string) (*Response, error)
 }
 
 type Client struct {
@@ -52,22 +56,22 @@ type Response struct {
 	Usage  Usage  `json:"usage"`
 }
 
-func (c *Client) CreateEmbedding(req Request) (*Response, error) {
+func (c *Client) CreateEmbedding(ctx context.Context, req Request) (*Response, error) {
 	var result Response
-	if err := c.httpClient.Post("/embeddings", req, &result); err != nil {
+	if err := c.httpClient.Post(ctx, "/embeddings", req, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (c *Client) CreateEmbeddingWithDefaults(model, text string) (*Response, error) {
+func (c *Client) CreateEmbeddingWithDefaults(ctx context.Context, model, text string) (*Response, error) {
 	req := Request{Model: model, Input: text, EncodingFormat: "float"}
-	return c.CreateEmbedding(req)
+	return c.CreateEmbedding(ctx, req)
 }
 
-func (c *Client) CreateBatchEmbedding(model string, texts []string) (*Response, error) {
+func (c *Client) CreateBatchEmbedding(ctx context.Context, model string, texts []string) (*Response, error) {
 	req := Request{Model: model, Input: texts, EncodingFormat: "float"}
-	return c.CreateEmbedding(req)
+	return c.CreateEmbedding(ctx, req)
 }
 
 const (
@@ -113,6 +117,40 @@ func GetSupportedDimensions(model string) []int {
 	}
 }
 
+// ErrUnsupportedDimensions is returned for a vector size the model cannot
+// produce.
+var ErrUnsupportedDimensions = errors.New("unsupported embedding dimensions")
+
+// ResolveDimensions returns the vector size to use for model. Zero selects
+// the model's default; any other size must be one GetSupportedDimensions
+// lists, and models without a list only accept their default.
+func ResolveDimensions(model string, dims int) (int, error) {
+	if dims == 0 {
+		return GetDefaultDimensions(model), nil
+	}
+	supported := GetSupportedDimensions(model)
+	if supported == nil {
+		if dims == GetDefaultDimensions(model) {
+			return dims, nil
+		}
+		return 0, fmt.Errorf("%w: %s does not support choosing dimensions", ErrUnsupportedDimensions, model)
+	}
+	if !slices.Contains(supported, dims) {
+		return 0, fmt.Errorf("%w: %s supports %v, got %d", ErrUnsupportedDimensions, model, supported, dims)
+	}
+	return dims, nil
+}
+
+// RequestDimensions returns the Request.Dimensions value that asks model for
+// dims-sized vectors: dims when the model can shorten its output to that
+// size, zero (the model's native size) otherwise.
+func RequestDimensions(model string, dims int) int {
+	if slices.Contains(GetSupportedDimensions(model), dims) {
+		return dims
+	}
+	return 0
+}
+
 func GetDefaultDimensions(model string) int {
 	switch model {
 	case ModelQwen3Embedding8B:
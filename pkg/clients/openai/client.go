@@ -1,6 +1,7 @@
 package openai
 
 import (
+	"context"
 	"time"
 
 	"github.com/hsn0918/rag/pkg/clients/base"
@@ -16,8 +17,13 @@ const (
 )
 
 type ChatCompleter interface {
-	CreateChatCompletion(req ChatRequest) (*ChatResponse, error)
-	CreateChatCompletionWithDefaults(model string, messages []Message) (*ChatResponse, error)
+	CreateChatCompletion(ctx context.Context, req ChatRequest) (*ChatResponse, error)
+	CreateChatCompletionWithDefaults(ctx context.Context, model string, messages []Message) (*ChatResponse, error)
+	CreateChatCompletionWithFormat(ctx context.Context, model string, messages []Message, format *ResponseFormat) (*ChatResponse, error)
+	CreateChatCompletionStream(ctx context.Context, req ChatRequest) (*ChatStream, error)
+	CreateChatCompletionStreamWithDefaults(ctx context.Context, model string, messages []Message) (*ChatStream, error)
+	// Available reports false while the circuit breaker rejects calls
+	Available() bool
 }
 
 type Client struct {
@@ -28,7 +34,12 @@ type Client struct {
 var _ ChatCompleter = (*Client)(nil)
 
 func NewClient(cfg config.ServiceConfig) *Client {
-	httpClient := base.NewHTTPClient(ServiceName, cfg, DefaultTimeout)
+	return newClient(ServiceName, cfg)
+}
+
+// newClient names the client's stats after service.
+func newClient(service string, cfg config.ServiceConfig) *Client {
+	httpClient := base.NewHTTPClient(service, cfg, DefaultTimeout)
 	return &Client{httpClient: httpClient, config: cfg}
 }
 
@@ -46,9 +57,27 @@ type Tool struct {
 	} `json:"function"`
 }
 
+// Response format types.
+const (
+	ResponseFormatText       = "text"
+	ResponseFormatJSONObject = "json_object"
+	ResponseFormatJSONSchema = "json_schema"
+)
+
 type ResponseFormat struct {
-	Type   string      `json:"type"`
-	Schema interface{} `json:"schema,omitempty"`
+	Type string `json:"type"`
+	// JSONSchema is required for the json_schema type.
+	JSONSchema *JSONSchema `json:"json_schema,omitempty"`
+}
+
+// JSONSchema constrains a json_schema response format.
+type JSONSchema struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Schema      interface{} `json:"schema"`
+	// Strict makes the provider follow the schema exactly; it requires
+	// every property to be required and additionalProperties to be false.
+	Strict bool `json:"strict,omitempty"`
 }
 
 type ChatRequest struct {
@@ -88,15 +117,25 @@ type ChatResponse struct {
 	Usage   Usage    `json:"usage"`
 }
 
-func (c *Client) CreateChatCompletion(req ChatRequest) (*ChatResponse, error) {
+func (c *Client) Available() bool {
+	return c.httpClient.Available()
+}
+
+func (c *Client) CreateChatCompletion(ctx context.Context, req ChatRequest) (*ChatResponse, error) {
 	var result ChatResponse
-	if err := c.httpClient.Post("/chat/completions", req, &result); err != nil {
+	if err := c.httpClient.Post(ctx, "/chat/completions", req, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-func (c *Client) CreateChatCompletionWithDefaults(model string, messages []Message) (*ChatResponse, error) {
-	req := ChatRequest{Model: model, Messages: messages, Stream: false, MaxTokens: DefaultMaxTokens, Temperature: DefaultTemperature, TopP: DefaultTopP}
-	return c.CreateChatCompletion(req)
+func (c *Client) CreateChatCompletionWithDefaults(ctx context.Context, model string, messages []Message) (*ChatResponse, error) {
+	return c.CreateChatCompletionWithFormat(ctx, model, messages, nil)
+}
+
+// CreateChatCompletionWithFormat completes messages with default sampling
+// settings, asking for the given response format; nil leaves it unset.
+func (c *Client) CreateChatCompletionWithFormat(ctx context.Context, model string, messages []Message, format *ResponseFormat) (*ChatResponse, error) {
+	req := ChatRequest{Model: model, Messages: messages, Stream: false, MaxTokens: DefaultMaxTokens, Temperature: DefaultTemperature, TopP: DefaultTopP, ResponseFormat: format}
+	return c.CreateChatCompletion(ctx, req)
 }
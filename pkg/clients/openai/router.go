@@ -0,0 +1,152 @@
+package openai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hsn0918/rag/pkg/config"
+)
+
+// Task names the purpose of a chat completion so it can be routed to
+// different providers.
+type Task string
+
+const (
+	TaskKeywords     Task = "keywords"
+	TaskSummary      Task = "summary"
+	TaskQueryRewrite Task = "query_rewrite"
+)
+
+var (
+	// ErrModelNotAllowed is returned for a requested model outside the
+	// configured allowlist.
+	ErrModelNotAllowed = errors.New("model is not allowed")
+	// ErrNoProvider is returned when every provider for a call failed or
+	// was unavailable.
+	ErrNoProvider = errors.New("no LLM provider succeeded")
+)
+
+type provider struct {
+	cfg    config.LLMProviderConfig
+	client *Client
+}
+
+// Router sends chat completions to a chain of providers, falling back to
+// the next one when a provider fails or its circuit breaker is open.
+type Router struct {
+	providers []*provider
+	routes    map[Task][]*provider
+	allowed   []string
+}
+
+// NewRouter creates a client per configured provider. cfg must have been
+// validated.
+func NewRouter(cfg config.LLMConfig) (*Router, error) {
+	r := &Router{routes: make(map[Task][]*provider), allowed: cfg.AllowedModels}
+	byName := make(map[string]*provider, len(cfg.Providers))
+	for _, pc := range cfg.Providers {
+		p := &provider{cfg: pc, client: newClient(ServiceName+"/"+pc.Name, pc.ServiceConfig)}
+		r.providers = append(r.providers, p)
+		byName[pc.Name] = p
+	}
+	if len(r.providers) == 0 {
+		return nil, fmt.Errorf("%w: no providers configured", ErrNoProvider)
+	}
+	for task, names := range cfg.Routes {
+		switch Task(task) {
+		case TaskKeywords, TaskSummary, TaskQueryRewrite:
+		default:
+			return nil, fmt.Errorf("unknown LLM task %q in routes", task)
+		}
+		for _, name := range names {
+			p, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("route %q uses unknown provider %q", task, name)
+			}
+			r.routes[Task(task)] = append(r.routes[Task(task)], p)
+		}
+	}
+	return r, nil
+}
+
+// CheckModel validates a per-request model. The empty model selects the
+// provider defaults and is always allowed.
+func (r *Router) CheckModel(model string) error {
+	if model == "" || slices.Contains(r.allowed, model) {
+		return nil
+	}
+	return fmt.Errorf("%w: %q", ErrModelNotAllowed, model)
+}
+
+// chain returns the providers to try for task in order. With a model set
+// only providers serving it are kept, falling back to any provider serving
+// it when the route has none.
+func (r *Router) chain(task Task, model string) []*provider {
+	route, ok := r.routes[task]
+	if !ok {
+		route = r.providers
+	}
+	if model == "" {
+		return route
+	}
+	other := func(p *provider) bool { return !p.cfg.Serves(model) }
+	if chain := slices.DeleteFunc(slices.Clone(route), other); len(chain) > 0 {
+		return chain
+	}
+	return slices.DeleteFunc(slices.Clone(r.providers), other)
+}
+
+// Available reports whether any provider for task admits calls.
+func (r *Router) Available(task Task) bool {
+	return slices.ContainsFunc(r.chain(task, ""), func(p *provider) bool { return p.client.Available() })
+}
+
+// try calls fn for each provider of task until one succeeds. Providers with
+// an open breaker are skipped, and a cancelled ctx stops the chain.
+func try[T any](ctx context.Context, r *Router, task Task, model string, fn func(p *provider, model string) (T, error)) (T, error) {
+	var (
+		zero T
+		errs []error
+	)
+	for _, p := range r.chain(task, model) {
+		if !p.client.Available() {
+			errs = append(errs, fmt.Errorf("%s: circuit breaker is open", p.cfg.Name))
+			continue
+		}
+		m := model
+		if m == "" {
+			m = p.cfg.Model
+		}
+		result, err := fn(p, m)
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			return zero, err
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", p.cfg.Name, err))
+	}
+	if len(errs) == 0 {
+		return zero, fmt.Errorf("%w for %s", ErrNoProvider, task)
+	}
+	return zero, fmt.Errorf("%w for %s: %w", ErrNoProvider, task, errors.Join(errs...))
+}
+
+// CreateChatCompletion completes messages with default sampling settings.
+// An empty model uses each provider's configured model.
+func (r *Router) CreateChatCompletion(ctx context.Context, task Task, model string, messages []Message) (*ChatResponse, error) {
+	return try(ctx, r, task, model, func(p *provider, model string) (*ChatResponse, error) {
+		return p.client.CreateChatCompletionWithDefaults(ctx, model, messages)
+	})
+}
+
+// CreateChatCompletionStream streams a completion. Providers are only
+// switched before the stream starts; a stream failing midway is not
+// retried.
+func (r *Router) CreateChatCompletionStream(ctx context.Context, task Task, model string, messages []Message) (*ChatStream, error) {
+	return try(ctx, r, task, model, func(p *provider, model string) (*ChatStream, error) {
+		return p.client.CreateChatCompletionStreamWithDefaults(ctx, model, messages)
+	})
+}
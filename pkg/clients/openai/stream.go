@@ -0,0 +1,103 @@
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hsn0918/rag/pkg/clients/base"
+)
+
+const (
+	sseDataPrefix = "data:"
+	sseDoneMarker = "[DONE]"
+	// maxSSELineSize bounds a single SSE line; providers occasionally emit
+	// large chunks when they batch tokens together.
+	maxSSELineSize = 1 << 20
+)
+
+type Delta struct {
+	Role    string `json:"role,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+type StreamChoice struct {
+	Index        int    `json:"index"`
+	Delta        Delta  `json:"delta"`
+	FinishReason string `json:"finish_reason,omitempty"`
+}
+
+type ChatStreamChunk struct {
+	ID      string         `json:"id"`
+	Object  string         `json:"object"`
+	Created int64          `json:"created"`
+	Model   string         `json:"model"`
+	Choices []StreamChoice `json:"choices"`
+	Usage   *Usage         `json:"usage,omitempty"`
+}
+
+// ChatStream reads chat completion chunks from a server-sent events body.
+// Recv returns io.EOF once the provider sends the [DONE] marker or closes
+// the connection.
+type ChatStream struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+	done    bool
+}
+
+func newChatStream(body io.ReadCloser) *ChatStream {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxSSELineSize)
+	return &ChatStream{body: body, scanner: scanner}
+}
+
+func (s *ChatStream) Recv() (*ChatStreamChunk, error) {
+	if s.done {
+		return nil, io.EOF
+	}
+	for s.scanner.Scan() {
+		line := bytes.TrimSpace(s.scanner.Bytes())
+		// Blank lines separate events; lines starting with ':' are comments
+		// that some providers send as keep-alives.
+		if len(line) == 0 || line[0] == ':' || !bytes.HasPrefix(line, []byte(sseDataPrefix)) {
+			continue
+		}
+		data := bytes.TrimSpace(line[len(sseDataPrefix):])
+		if string(data) == sseDoneMarker {
+			s.done = true
+			return nil, io.EOF
+		}
+		var chunk ChatStreamChunk
+		if err := json.Unmarshal(data, &chunk); err != nil {
+			return nil, base.NewClientError(ServiceName, "decode stream chunk", fmt.Errorf("%w: %s", err, data))
+		}
+		return &chunk, nil
+	}
+	if err := s.scanner.Err(); err != nil {
+		return nil, base.NewClientError(ServiceName, "read stream", err)
+	}
+	s.done = true
+	return nil, io.EOF
+}
+
+func (s *ChatStream) Close() error {
+	s.done = true
+	return s.body.Close()
+}
+
+func (c *Client) CreateChatCompletionStream(ctx context.Context, req ChatRequest) (*ChatStream, error) {
+	req.Stream = true
+	body, err := c.httpClient.PostStream(ctx, "/chat/completions", req)
+	if err != nil {
+		return nil, err
+	}
+	return newChatStream(body), nil
+}
+
+func (c *Client) CreateChatCompletionStreamWithDefaults(ctx context.Context, model string, messages []Message) (*ChatStream, error) {
+	req := ChatRequest{Model: model, Messages: messages, Stream: true, MaxTokens: DefaultMaxTokens, Temperature: DefaultTemperature, TopP: DefaultTopP}
+	return c.CreateChatCompletionStream(ctx, req)
+}
@@ -0,0 +1,99 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hsn0918/rag/pkg/config"
+)
+
+var (
+	// ErrNoJSON is returned when a structured output contains no JSON
+	// object.
+	ErrNoJSON = errors.New("no JSON object in output")
+	// ErrInvalidOutput is returned when a decoded output fails validation.
+	ErrInvalidOutput = errors.New("invalid structured output")
+)
+
+// Validator is implemented by structured outputs that check their content
+// after decoding.
+type Validator interface {
+	Validate() error
+}
+
+// StructuredRequest is a completion whose output follows a JSON schema on
+// providers with structured output, and a prompt-defined text format on
+// the others.
+type StructuredRequest struct {
+	// Schema describes the JSON output. Providers in json_object mode only
+	// rely on Messages describing it.
+	Schema JSONSchema
+	// Messages ask for JSON output.
+	Messages []Message
+	// Fallback asks for the text format, e.g. XML, and is sent to
+	// providers without structured output.
+	Fallback []Message
+}
+
+// StructuredResponse is the completion of a StructuredRequest.
+type StructuredResponse struct {
+	*ChatResponse
+	// Structured reports that the provider answered Messages in a JSON
+	// response format rather than Fallback.
+	Structured bool
+}
+
+// responseFormat returns the response format the provider is asked for,
+// or nil when it has no structured output.
+func (p *provider) responseFormat(schema JSONSchema) *ResponseFormat {
+	switch p.cfg.StructuredOutput {
+	case config.StructuredOutputJSONSchema:
+		return &ResponseFormat{Type: ResponseFormatJSONSchema, JSONSchema: &schema}
+	case config.StructuredOutputJSONObject:
+		return &ResponseFormat{Type: ResponseFormatJSONObject}
+	default:
+		return nil
+	}
+}
+
+// CreateStructuredCompletion completes req with each provider's best
+// supported format. A provider without structured output is sent
+// req.Fallback.
+func (r *Router) CreateStructuredCompletion(ctx context.Context, task Task, model string, req StructuredRequest) (*StructuredResponse, error) {
+	return try(ctx, r, task, model, func(p *provider, model string) (*StructuredResponse, error) {
+		format := p.responseFormat(req.Schema)
+		messages := req.Messages
+		if format == nil {
+			messages = req.Fallback
+		}
+		resp, err := p.client.CreateChatCompletionWithFormat(ctx, model, messages, format)
+		if err != nil {
+			return nil, err
+		}
+		return &StructuredResponse{ChatResponse: resp, Structured: format != nil}, nil
+	})
+}
+
+// DecodeJSON decodes a structured output into T. Code fences or text
+// around the outermost JSON object are ignored, and T is validated when it
+// implements Validator.
+func DecodeJSON[T any](content string) (T, error) {
+	var out T
+	start := strings.IndexByte(content, '{')
+	end := strings.LastIndexByte(content, '}')
+	if start < 0 || end < start {
+		return out, ErrNoJSON
+	}
+	if err := json.Unmarshal([]byte(content[start:end+1]), &out); err != nil {
+		return out, fmt.Errorf("decode structured output: %w", err)
+	}
+	if v, ok := any(&out).(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return out, fmt.Errorf("%w: %w", ErrInvalidOutput, err)
+		}
+	}
+	return out, nil
+}
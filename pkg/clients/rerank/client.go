@@ -1,6 +1,7 @@
 package rerank
 
 import (
+	"context"
 	"time"
 
 	"github.com/hsn0918/rag/pkg/clients/base"
@@ -12,12 +13,71 @@ const (
 	ServiceName    = "rerank"
 )
 
+// Reranker scores documents against a query with a cross-encoder model.
+type Reranker interface {
+	Rerank(ctx context.Context, model, query string, documents []string, topN int) (*Response, error)
+}
+
 type Client struct {
 	httpClient *base.HTTPClient
 	config     config.ServiceConfig
 }
 
+var _ Reranker = (*Client)(nil)
+
 func NewClient(cfg config.ServiceConfig) *Client {
 	httpClient := base.NewHTTPClient(ServiceName, cfg, DefaultTimeout)
 	return &Client{httpClient: httpClient, config: cfg}
 }
+
+// Request follows the /rerank shape shared by SiliconFlow, Jina, Cohere
+// and most self-hosted cross-encoder servers.
+type Request struct {
+	Model           string   `json:"model"`
+	Query           string   `json:"query"`
+	Documents       []string `json:"documents"`
+	TopN            int      `json:"top_n,omitempty"`
+	ReturnDocuments bool     `json:"return_documents"`
+}
+type Result struct {
+	// Index points into Request.Documents.
+	Index          int     `json:"index"`
+	RelevanceScore float64 `json:"relevance_score"`
+}
+type Tokens struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+type Meta struct {
+	Tokens Tokens `json:"tokens"`
+}
+
+// Response lists results ordered by descending relevance.
+type Response struct {
+	ID      string   `json:"id"`
+	Results []Result `json:"results"`
+	Meta    Meta     `json:"meta"`
+}
+
+// Rerank scores documents against query and returns the topN most relevant;
+// topN <= 0 returns all of them. An empty model uses the configured one.
+func (c *Client) Rerank(ctx context.Context, model, query string, documents []string, topN int) (*Response, error) {
+	if model == "" {
+		model = c.config.Model
+	}
+	req := Request{Model: model, Query: query, Documents: documents, TopN: topN}
+	var result Response
+	if err := c.httpClient.Post(ctx, "/rerank", req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+const (
+	ModelBGERerankerV2M3    = "BAAI/bge-reranker-v2-m3"
+	ModelProBGERerankerV2M3 = "Pro/BAAI/bge-reranker-v2-m3"
+	ModelBCERerankerBaseV1  = "netease-youdao/bce-reranker-base_v1"
+	ModelQwen3Reranker8B    = "Qwen/Qwen3-Reranker-8B"
+	ModelQwen3Reranker4B    = "Qwen/Qwen3-Reranker-4B"
+	ModelQwen3Reranker06B   = "Qwen/Qwen3-Reranker-0.6B"
+)
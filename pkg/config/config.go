@@ -5,6 +5,8 @@ package config
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -24,6 +26,165 @@ type ServiceConfig struct {
 
 	// Service settings
 	Model string `mapstructure:"model" validate:"required"`
+	// Timeout bounds each call including retries; zero uses the client's
+	// default.
+	Timeout time.Duration `mapstructure:"timeout" validate:"min=0"`
+
+	// Resilience settings
+	Resilience ResilienceConfig `mapstructure:"resilience"`
+}
+
+// Validate checks the service settings. Zero values select the client
+// defaults.
+func (c ServiceConfig) Validate() error {
+	if c.Timeout < 0 {
+		return fmt.Errorf("%w: timeout must not be negative", ErrInvalidConfig)
+	}
+	return c.Resilience.Validate()
+}
+
+// ResilienceConfig tunes how a client protects itself from a slow or
+// failing service. Zero values select the client defaults, except for
+// MaxRetries, where only an unset value does.
+type ResilienceConfig struct {
+	// RateLimit caps requests per second with a token bucket; zero
+	// disables the limit.
+	RateLimit float64 `mapstructure:"rate_limit" validate:"min=0"`
+	// Burst is the bucket size; zero allows one second worth of requests.
+	Burst int `mapstructure:"burst" validate:"min=0"`
+
+	// MaxRetries caps retries after a transport error, 429 or 5xx. Zero
+	// disables retries; unset uses the default of 3.
+	MaxRetries *int `mapstructure:"max_retries" validate:"omitempty,min=0,max=10"`
+
+	// FailureThreshold is the number of consecutive failures that opens
+	// the circuit breaker.
+	FailureThreshold int `mapstructure:"failure_threshold" validate:"min=0"`
+	// OpenTimeout is how long an open breaker rejects calls before letting
+	// a probe through.
+	OpenTimeout time.Duration `mapstructure:"open_timeout" validate:"min=0"`
+}
+
+// Validate checks the resilience settings.
+func (c ResilienceConfig) Validate() error {
+	if c.RateLimit < 0 || c.Burst < 0 || c.FailureThreshold < 0 || c.OpenTimeout < 0 {
+		return fmt.Errorf("%w: rate limit, burst, failure threshold and open timeout must not be negative", ErrInvalidConfig)
+	}
+	if c.MaxRetries != nil && (*c.MaxRetries < 0 || *c.MaxRetries > 10) {
+		return fmt.Errorf("%w: max retries must be between 0 and 10", ErrInvalidConfig)
+	}
+	return nil
+}
+
+// DefaultLLMProvider names the provider configured through the flat
+// services.llm fields.
+const DefaultLLMProvider = "default"
+
+// Structured output modes of an LLM provider.
+const (
+	// StructuredOutputNone asks for XML in the prompt and scrapes it.
+	StructuredOutputNone = "none"
+	// StructuredOutputJSONObject requests a JSON object response format
+	// and describes the fields in the prompt.
+	StructuredOutputJSONObject = "json_object"
+	// StructuredOutputJSONSchema requests a response format constrained by
+	// the prompt's JSON schema.
+	StructuredOutputJSONSchema = "json_schema"
+)
+
+// LLMConfig lists the chat model providers and how calls are routed to
+// them.
+type LLMConfig struct {
+	// ServiceConfig configures a single provider named "default". It is
+	// only used when Providers is empty.
+	ServiceConfig `mapstructure:",squash"`
+	// StructuredOutput is the structured output mode of the "default"
+	// provider.
+	StructuredOutput string `mapstructure:"structured_output"`
+
+	// Providers are tried in this order unless a route says otherwise.
+	Providers []LLMProviderConfig `mapstructure:"providers"`
+
+	// Routes maps a task ("keywords", "summary" or "query_rewrite") to the
+	// provider names tried for it, in order.
+	Routes map[string][]string `mapstructure:"routes"`
+
+	// AllowedModels lists the models a request may select instead of the
+	// provider defaults; empty disables per-request models.
+	AllowedModels []string `mapstructure:"allowed_models"`
+}
+
+// LLMProviderConfig is one OpenAI-compatible chat completion endpoint.
+type LLMProviderConfig struct {
+	Name          string `mapstructure:"name" validate:"required"`
+	ServiceConfig `mapstructure:",squash"`
+	// Models lists further models the provider serves, for requests that
+	// select one.
+	Models []string `mapstructure:"models"`
+	// StructuredOutput is "none" (default), "json_object" or "json_schema",
+	// depending on the response formats the provider supports.
+	StructuredOutput string `mapstructure:"structured_output"`
+}
+
+// Serves reports whether the provider serves model.
+func (p LLMProviderConfig) Serves(model string) bool {
+	return model == p.Model || slices.Contains(p.Models, model)
+}
+
+// Validate checks the provider list and routes, turning the flat fields
+// into the "default" provider when no list is given.
+func (c *LLMConfig) Validate() error {
+	if len(c.Providers) == 0 && c.BaseURL != "" {
+		c.Providers = []LLMProviderConfig{{
+			Name:             DefaultLLMProvider,
+			ServiceConfig:    c.ServiceConfig,
+			StructuredOutput: c.StructuredOutput,
+		}}
+	}
+	if len(c.Providers) == 0 {
+		return fmt.Errorf("%w: at least one provider is required", ErrInvalidConfig)
+	}
+
+	names := make(map[string]bool, len(c.Providers))
+	for i := range c.Providers {
+		p := &c.Providers[i]
+		if p.Name == "" {
+			return fmt.Errorf("%w: provider name is required", ErrInvalidConfig)
+		}
+		if names[p.Name] {
+			return fmt.Errorf("%w: duplicate provider %q", ErrInvalidConfig, p.Name)
+		}
+		names[p.Name] = true
+		if p.Model == "" {
+			return fmt.Errorf("%w: provider %q has no model", ErrInvalidConfig, p.Name)
+		}
+		if err := p.ServiceConfig.Validate(); err != nil {
+			return fmt.Errorf("provider %q: %w", p.Name, err)
+		}
+		switch p.StructuredOutput {
+		case "":
+			p.StructuredOutput = StructuredOutputNone
+		case StructuredOutputNone, StructuredOutputJSONObject, StructuredOutputJSONSchema:
+		default:
+			return fmt.Errorf("%w: provider %q has unknown structured output mode %q", ErrInvalidConfig, p.Name, p.StructuredOutput)
+		}
+	}
+	for task, route := range c.Routes {
+		if len(route) == 0 {
+			return fmt.Errorf("%w: route %q lists no providers", ErrInvalidConfig, task)
+		}
+		for _, name := range route {
+			if !names[name] {
+				return fmt.Errorf("%w: route %q uses unknown provider %q", ErrInvalidConfig, task, name)
+			}
+		}
+	}
+	for _, model := range c.AllowedModels {
+		if !slices.ContainsFunc(c.Providers, func(p LLMProviderConfig) bool { return p.Serves(model) }) {
+			return fmt.Errorf("%w: no provider serves allowed model %q", ErrInvalidConfig, model)
+		}
+	}
+	return nil
 }
 
 // ChunkingConfig defines text chunking parameters.
@@ -66,6 +227,227 @@ func (c *ChunkingConfig) Validate() error {
 	return nil
 }
 
+// Modes for the local PDF extractor.
+const (
+	// LocalExtractorPrimary extracts PDFs locally and only calls Doc2X
+	// when the PDF has no text layer.
+	LocalExtractorPrimary = "primary"
+	// LocalExtractorFallback calls Doc2X and extracts locally when Doc2X
+	// is not configured or fails.
+	LocalExtractorFallback = "fallback"
+	// LocalExtractorDisabled always uses Doc2X.
+	LocalExtractorDisabled = "disabled"
+)
+
+// DefaultDoc2XMaxWait is how long a Doc2X parse is polled by default.
+const DefaultDoc2XMaxWait = 5 * time.Minute
+
+// IngestionConfig controls the background workers that process uploads.
+type IngestionConfig struct {
+	// Workers is the number of jobs processed concurrently.
+	Workers int `mapstructure:"workers" validate:"min=1"`
+	// PollInterval is how often idle workers check the queue for new jobs.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// StaleAfter is how long a running job may go without a progress update
+	// before it is assumed abandoned and requeued.
+	StaleAfter time.Duration `mapstructure:"stale_after"`
+	// MaxAttempts bounds how many times an abandoned job is requeued.
+	MaxAttempts int `mapstructure:"max_attempts" validate:"min=1"`
+	// AllowPartial stores a document even when some of its chunks fail to
+	// embed, recording the failed chunk indices in the document metadata.
+	// When false a single failed chunk fails the whole ingestion. Reindex
+	// jobs ignore it and always require every chunk.
+	AllowPartial bool `mapstructure:"allow_partial"`
+	// DedupePolicy decides what happens when an upload's content hash
+	// matches an existing document: "reject", "return_existing" or
+	// "replace". Requests may override it.
+	DedupePolicy string `mapstructure:"dedupe_policy" validate:"oneof=reject return_existing replace"`
+}
+
+// Validate checks the ingestion configuration and sets defaults.
+func (c *IngestionConfig) Validate() error {
+	if c.Workers == 0 {
+		c.Workers = 2
+	}
+	if c.PollInterval == 0 {
+		c.PollInterval = 2 * time.Second
+	}
+	if c.StaleAfter == 0 {
+		c.StaleAfter = 10 * time.Minute
+	}
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = 3
+	}
+	if c.DedupePolicy == "" {
+		c.DedupePolicy = "return_existing"
+	}
+
+	if c.Workers < 0 || c.MaxAttempts < 0 {
+		return fmt.Errorf("%w: workers and max attempts must be positive", ErrInvalidConfig)
+	}
+	if c.PollInterval < 0 || c.StaleAfter < 0 {
+		return fmt.Errorf("%w: poll interval and stale after must be positive", ErrInvalidConfig)
+	}
+	switch c.DedupePolicy {
+	case "reject", "return_existing", "replace":
+	default:
+		return fmt.Errorf("%w: unknown dedupe policy %q", ErrInvalidConfig, c.DedupePolicy)
+	}
+
+	return nil
+}
+
+// SearchConfig tunes hybrid retrieval.
+type SearchConfig struct {
+	// Fusion merges vector and full-text results: "rrf", "weighted" or
+	// "average".
+	Fusion string `mapstructure:"fusion" validate:"oneof=rrf weighted average"`
+	// RRFK is the rank offset k of Reciprocal Rank Fusion.
+	RRFK float64 `mapstructure:"rrf_k" validate:"min=0"`
+	// VectorWeight and KeywordWeight weigh the normalized scores of each
+	// list under weighted fusion.
+	VectorWeight  float64 `mapstructure:"vector_weight" validate:"min=0"`
+	KeywordWeight float64 `mapstructure:"keyword_weight" validate:"min=0"`
+	// ContextTokens is the token budget for retrieved passages in the
+	// summary prompt.
+	ContextTokens int `mapstructure:"context_tokens" validate:"min=0"`
+}
+
+// DefaultContextTokens leaves room for the prompt template and a 4096 token
+// answer in a 16k context window.
+const DefaultContextTokens = 6000
+
+// Validate checks the search configuration and sets defaults.
+func (c *SearchConfig) Validate() error {
+	if c.Fusion == "" {
+		c.Fusion = "rrf"
+	}
+	if c.RRFK == 0 {
+		c.RRFK = 60
+	}
+	if c.VectorWeight == 0 && c.KeywordWeight == 0 {
+		c.VectorWeight = 0.7
+		c.KeywordWeight = 0.3
+	}
+	if c.ContextTokens == 0 {
+		c.ContextTokens = DefaultContextTokens
+	}
+	if c.ContextTokens < 0 {
+		return fmt.Errorf("%w: context tokens must not be negative", ErrInvalidConfig)
+	}
+
+	if c.RRFK < 0 || c.VectorWeight < 0 || c.KeywordWeight < 0 {
+		return fmt.Errorf("%w: rrf k and fusion weights must not be negative", ErrInvalidConfig)
+	}
+	switch c.Fusion {
+	case "rrf", "weighted", "average":
+	default:
+		return fmt.Errorf("%w: unknown fusion strategy %q", ErrInvalidConfig, c.Fusion)
+	}
+
+	return nil
+}
+
+// Vector index types.
+const (
+	VectorIndexHNSW    = "hnsw"
+	VectorIndexIVFFlat = "ivfflat"
+	VectorIndexNone    = "none"
+)
+
+// VectorIndexConfig controls the approximate nearest neighbour index on
+// chunk embeddings. Build parameters only apply when the index is created;
+// drop the index to rebuild it with new values.
+type VectorIndexConfig struct {
+	// Type is "hnsw", "ivfflat" or "none" for exact sequential scans.
+	Type string `mapstructure:"type" validate:"oneof=hnsw ivfflat none"`
+
+	// HNSW build parameters.
+	M              int `mapstructure:"m" validate:"min=2,max=100"`
+	EfConstruction int `mapstructure:"ef_construction" validate:"min=4,max=1000"`
+
+	// Lists is the IVFFlat cluster count; zero derives it from the number
+	// of chunks when the index is built.
+	Lists int `mapstructure:"lists" validate:"min=0,max=32768"`
+
+	// Query-time defaults, overridable per request.
+	EfSearch int `mapstructure:"ef_search" validate:"min=1,max=1000"`
+	Probes   int `mapstructure:"probes" validate:"min=1,max=32768"`
+
+	// IterativeScan lets filtered HNSW/IVFFlat scans continue until enough
+	// rows pass the filter: "off", "relaxed_order" or "strict_order".
+	// Needs pgvector 0.8 or later.
+	IterativeScan string `mapstructure:"iterative_scan" validate:"oneof=off relaxed_order strict_order"`
+
+	// TwoStageDimensions, when set below the embedding dimensions, indexes
+	// only the first TwoStageDimensions components of each vector. Searches
+	// retrieve candidates with that prefix and rescore them with the full
+	// vector. Meant for Matryoshka models such as Qwen3 Embedding, whose
+	// vector prefixes are embeddings themselves. Zero disables it.
+	TwoStageDimensions int `mapstructure:"two_stage_dimensions" validate:"min=0,max=4000"`
+	// RescoreFactor is how many candidates per requested result a reduced
+	// index retrieves for rescoring.
+	RescoreFactor int `mapstructure:"rescore_factor" validate:"min=1,max=50"`
+}
+
+// Validate checks the vector index configuration and sets defaults.
+func (c *VectorIndexConfig) Validate() error {
+	if c.Type == "" {
+		c.Type = VectorIndexHNSW
+	}
+	if c.M == 0 {
+		c.M = 16
+	}
+	if c.EfConstruction == 0 {
+		c.EfConstruction = 64
+	}
+	if c.EfSearch == 0 {
+		c.EfSearch = 40
+	}
+	if c.Probes == 0 {
+		c.Probes = 10
+	}
+	if c.IterativeScan == "" {
+		c.IterativeScan = "off"
+	}
+	if c.RescoreFactor == 0 {
+		c.RescoreFactor = 4
+	}
+
+	switch c.Type {
+	case VectorIndexHNSW, VectorIndexIVFFlat, VectorIndexNone:
+	default:
+		return fmt.Errorf("%w: unknown vector index type %q", ErrInvalidConfig, c.Type)
+	}
+	switch c.IterativeScan {
+	case "off", "relaxed_order", "strict_order":
+	default:
+		return fmt.Errorf("%w: unknown iterative scan mode %q", ErrInvalidConfig, c.IterativeScan)
+	}
+	if c.M < 2 || c.M > 100 {
+		return fmt.Errorf("%w: hnsw m must be between 2 and 100", ErrInvalidConfig)
+	}
+	// pgvector rejects ef_construction below 2*m.
+	if c.EfConstruction < 2*c.M || c.EfConstruction > 1000 {
+		return fmt.Errorf("%w: hnsw ef_construction must be between 2*m and 1000", ErrInvalidConfig)
+	}
+	if c.EfSearch < 1 || c.EfSearch > 1000 {
+		return fmt.Errorf("%w: hnsw ef_search must be between 1 and 1000", ErrInvalidConfig)
+	}
+	if c.Lists < 0 || c.Lists > 32768 || c.Probes < 1 || c.Probes > 32768 {
+		return fmt.Errorf("%w: ivfflat lists and probes must be between 1 and 32768", ErrInvalidConfig)
+	}
+	// HNSW and IVFFlat index at most 4000 halfvec dimensions.
+	if c.TwoStageDimensions < 0 || c.TwoStageDimensions > 4000 {
+		return fmt.Errorf("%w: two stage dimensions must be between 0 and 4000", ErrInvalidConfig)
+	}
+	if c.RescoreFactor < 1 || c.RescoreFactor > 50 {
+		return fmt.Errorf("%w: rescore factor must be between 1 and 50", ErrInvalidConfig)
+	}
+
+	return nil
+}
+
 // Config represents the complete application configuration.
 // Structs are organized by functional domain with clear separation.
 type Config struct {
@@ -102,16 +484,42 @@ type Config struct {
 	} `mapstructure:"minio"`
 
 	// Processing configuration
-	Chunking ChunkingConfig `mapstructure:"chunking"`
+	Chunking  ChunkingConfig  `mapstructure:"chunking"`
+	Ingestion IngestionConfig `mapstructure:"ingestion"`
+	Search    SearchConfig    `mapstructure:"search"`
+
+	// Vector index configuration
+	VectorIndex VectorIndexConfig `mapstructure:"vector_index"`
 
 	// External services configuration
 	Services struct {
-		Doc2X     ServiceConfig `mapstructure:"doc2x"`
+		Doc2X struct {
+			ServiceConfig `mapstructure:",squash"`
+			// LocalExtractor sets how the built-in PDF text extractor is
+			// used: "primary", "fallback" or "disabled".
+			LocalExtractor string `mapstructure:"local_extractor" validate:"oneof=primary fallback disabled"`
+			// MaxWait bounds how long a parse is polled before giving up.
+			MaxWait time.Duration `mapstructure:"max_wait" validate:"min=0"`
+		} `mapstructure:"doc2x"`
 		Embedding struct {
 			ServiceConfig `mapstructure:",squash"`
+			// BatchSize caps how many texts are embedded per request.
+			BatchSize int `mapstructure:"batch_size" validate:"min=0"`
+			// MaxBatchTokens caps the estimated tokens per request; zero
+			// derives it from the model's input limit.
+			MaxBatchTokens int `mapstructure:"batch_max_tokens" validate:"min=0"`
+			// Dimensions is the embedding size requested from the model and
+			// used for the vector tables. It must be one of the model's
+			// supported sizes; zero selects the model's default.
+			Dimensions int `mapstructure:"dimensions" validate:"min=0"`
 		} `mapstructure:"embedding"`
-		Reranker ServiceConfig `mapstructure:"reranker"`
-		LLM      ServiceConfig `mapstructure:"llm"`
+		Reranker struct {
+			ServiceConfig `mapstructure:",squash"`
+			// Enabled reranks hybrid search candidates with the model,
+			// replacing the keyword heuristic.
+			Enabled bool `mapstructure:"enabled"`
+		} `mapstructure:"reranker"`
+		LLM LLMConfig `mapstructure:"llm"`
 	} `mapstructure:"services"`
 }
 
@@ -122,6 +530,59 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("chunking config: %w", err)
 	}
 
+	// Validate ingestion configuration
+	if err := c.Ingestion.Validate(); err != nil {
+		return fmt.Errorf("ingestion config: %w", err)
+	}
+
+	// Validate search configuration
+	if err := c.Search.Validate(); err != nil {
+		return fmt.Errorf("search config: %w", err)
+	}
+
+	// Validate vector index configuration
+	if err := c.VectorIndex.Validate(); err != nil {
+		return fmt.Errorf("vector index config: %w", err)
+	}
+
+	// Validate embedding batching
+	if c.Services.Embedding.BatchSize < 0 || c.Services.Embedding.MaxBatchTokens < 0 || c.Services.Embedding.Dimensions < 0 {
+		return fmt.Errorf("%w: embedding batch size, token budget and dimensions must not be negative", ErrInvalidConfig)
+	}
+
+	// Validate PDF extraction mode
+	if c.Services.Doc2X.LocalExtractor == "" {
+		c.Services.Doc2X.LocalExtractor = LocalExtractorFallback
+	}
+	switch c.Services.Doc2X.LocalExtractor {
+	case LocalExtractorPrimary, LocalExtractorFallback, LocalExtractorDisabled:
+	default:
+		return fmt.Errorf("%w: unknown local extractor mode %q", ErrInvalidConfig, c.Services.Doc2X.LocalExtractor)
+	}
+	if c.Services.Doc2X.MaxWait == 0 {
+		c.Services.Doc2X.MaxWait = DefaultDoc2XMaxWait
+	}
+
+	if c.Services.Doc2X.MaxWait < 0 {
+		return fmt.Errorf("%w: doc2x max wait must not be negative", ErrInvalidConfig)
+	}
+
+	// Validate LLM providers and routing
+	if err := c.Services.LLM.Validate(); err != nil {
+		return fmt.Errorf("llm config: %w", err)
+	}
+
+	// Validate external service settings
+	for name, svc := range map[string]ServiceConfig{
+		"doc2x":     c.Services.Doc2X.ServiceConfig,
+		"embedding": c.Services.Embedding.ServiceConfig,
+		"reranker":  c.Services.Reranker.ServiceConfig,
+	} {
+		if err := svc.Validate(); err != nil {
+			return fmt.Errorf("%s service: %w", name, err)
+		}
+	}
+
 	// Additional validation logic can be added here
 	// such as checking database connectivity, service availability, etc.
 
@@ -177,6 +638,9 @@ func setDefaults() {
 	viper.SetDefault("chunking.adaptive_size", true)
 	viper.SetDefault("chunking.size_multiplier", 1.5)
 
+	// Embedding defaults
+	viper.SetDefault("services.embedding.batch_size", 32)
+
 	// Redis defaults
 	viper.SetDefault("redis.host", "localhost")
 	viper.SetDefault("redis.port", 6379)
@@ -0,0 +1,308 @@
+// Package contextpack fits retrieved chunks into a prompt's token budget.
+package contextpack
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hsn0918/rag/pkg/clients/embedding"
+)
+
+const (
+	// DefaultMinTrimTokens is the smallest trimmed chunk worth keeping.
+	DefaultMinTrimTokens = 32
+	// maxOverlapBytes bounds the search for text repeated between adjacent
+	// chunks when they are merged.
+	maxOverlapBytes = 1024
+	// minOverlapBytes avoids merging on coincidentally equal short runs.
+	minOverlapBytes = 8
+)
+
+// Tokenizer counts the tokens a text costs in the prompt.
+type Tokenizer interface {
+	Count(text string) int
+}
+
+// TokenizerFunc adapts a function to Tokenizer.
+type TokenizerFunc func(text string) int
+
+func (f TokenizerFunc) Count(text string) int { return f(text) }
+
+// EstimateTokenizer is the default tokenizer. It counts one token per CJK
+// character and one per four other characters.
+var EstimateTokenizer Tokenizer = TokenizerFunc(embedding.EstimateTokens)
+
+// Chunk is a retrieved text to pack.
+type Chunk struct {
+	DocumentID string
+	// Index is the chunk's position in its document. Packed chunks with
+	// consecutive indexes in the same document are merged.
+	Index   int
+	Content string
+	// Score orders chunks; higher scores are packed first.
+	Score float64
+}
+
+// Passage is one or more adjacent chunks of a document placed in the
+// prompt.
+type Passage struct {
+	DocumentID string
+	// Chunks are the positions in the packed slice of the chunks merged
+	// into the passage, in document order.
+	Chunks  []int
+	Content string
+	// Score is the highest score among the merged chunks.
+	Score  float64
+	Tokens int
+	// Truncated reports that the last chunk was cut at a sentence
+	// boundary to fit the budget.
+	Truncated bool
+}
+
+// Result is the outcome of Pack.
+type Result struct {
+	// Passages are ordered by descending score.
+	Passages []Passage
+	// Dropped are the positions of the chunks that did not fit, in score
+	// order.
+	Dropped []int
+	// Tokens is the budget used, including per-passage overhead.
+	Tokens int
+}
+
+// Packer fills a token budget with the highest scoring chunks.
+type Packer struct {
+	budget        int
+	tokenizer     Tokenizer
+	overhead      int
+	minTrimTokens int
+}
+
+// Option configures a Packer.
+type Option func(*Packer)
+
+// WithTokenizer replaces the default EstimateTokenizer.
+func WithTokenizer(t Tokenizer) Option {
+	return func(p *Packer) {
+		if t != nil {
+			p.tokenizer = t
+		}
+	}
+}
+
+// WithPassageOverhead reserves n tokens per chunk for the header the caller
+// renders around each passage.
+func WithPassageOverhead(n int) Option {
+	return func(p *Packer) {
+		if n >= 0 {
+			p.overhead = n
+		}
+	}
+}
+
+// WithMinTrimTokens sets the smallest trimmed chunk worth keeping; shorter
+// remainders drop the chunk instead.
+func WithMinTrimTokens(n int) Option {
+	return func(p *Packer) {
+		if n > 0 {
+			p.minTrimTokens = n
+		}
+	}
+}
+
+// New creates a Packer for a budget of tokens.
+func New(budget int, opts ...Option) *Packer {
+	p := &Packer{budget: budget, tokenizer: EstimateTokenizer, minTrimTokens: DefaultMinTrimTokens}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// selection is a chunk admitted to the budget, possibly trimmed.
+type selection struct {
+	pos       int
+	content   string
+	truncated bool
+	// order is the admission rank, used to break score ties.
+	order int
+}
+
+// Pack admits chunks in descending score order while they fit. A chunk
+// that does not fit is trimmed to whole sentences when enough budget is
+// left, otherwise dropped. Admitted chunks that are adjacent in the same
+// document are then merged into one passage.
+func (p *Packer) Pack(chunks []Chunk) Result {
+	order := make([]int, len(chunks))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return cmp.Compare(chunks[b].Score, chunks[a].Score) })
+
+	var (
+		result   Result
+		selected []selection
+	)
+	remaining := p.budget
+	for _, pos := range order {
+		content := chunks[pos].Content
+		room := remaining - p.overhead
+		tokens := p.tokenizer.Count(content)
+		truncated := false
+		if tokens > room {
+			// Only the first chunk may be cut mid-sentence, so the prompt
+			// never ends up without context.
+			content, tokens = p.trim(content, room, len(selected) == 0)
+			truncated = true
+		}
+		if content == "" {
+			result.Dropped = append(result.Dropped, pos)
+			continue
+		}
+		remaining -= tokens + p.overhead
+		selected = append(selected, selection{pos: pos, content: content, truncated: truncated, order: len(selected)})
+	}
+	result.Tokens = p.budget - remaining
+	result.Passages = p.merge(chunks, selected)
+	return result
+}
+
+// trim keeps the leading sentences of text that fit in budget tokens. It
+// returns "" when less than the minimum is left, unless hard is set, in
+// which case text without a fitting sentence is cut at a rune boundary.
+func (p *Packer) trim(text string, budget int, hard bool) (string, int) {
+	if budget < p.minTrimTokens {
+		return "", 0
+	}
+	end, used := 0, 0
+	for _, boundary := range sentenceEnds(text) {
+		n := p.tokenizer.Count(text[end:boundary])
+		if used+n > budget {
+			break
+		}
+		end, used = boundary, used+n
+	}
+	if end == 0 && hard {
+		// Binary search the longest rune prefix that fits.
+		lo, hi := 0, utf8.RuneCountInString(text)
+		for lo < hi {
+			mid := (lo + hi + 1) / 2
+			if p.tokenizer.Count(runePrefix(text, mid)) <= budget {
+				lo = mid
+			} else {
+				hi = mid - 1
+			}
+		}
+		end = len(runePrefix(text, lo))
+		used = p.tokenizer.Count(text[:end])
+	}
+	if end == 0 || used < p.minTrimTokens {
+		return "", 0
+	}
+	return strings.TrimSpace(text[:end]), used
+}
+
+// merge groups selected chunks into passages, joining chunks whose indexes
+// follow each other unless the earlier one was truncated.
+func (p *Packer) merge(chunks []Chunk, selected []selection) []Passage {
+	slices.SortFunc(selected, func(a, b selection) int {
+		ca, cb := chunks[a.pos], chunks[b.pos]
+		return cmp.Or(strings.Compare(ca.DocumentID, cb.DocumentID), cmp.Compare(ca.Index, cb.Index))
+	})
+
+	var (
+		passages []Passage
+		orders   []int
+	)
+	for i, sel := range selected {
+		chunk := chunks[sel.pos]
+		if i > 0 {
+			prev := selected[i-1]
+			last := &passages[len(passages)-1]
+			if chunk.DocumentID == last.DocumentID && chunk.Index == chunks[prev.pos].Index+1 && !prev.truncated {
+				last.Chunks = append(last.Chunks, sel.pos)
+				last.Content = joinOverlapping(last.Content, sel.content)
+				last.Score = max(last.Score, chunk.Score)
+				last.Truncated = sel.truncated
+				orders[len(orders)-1] = min(orders[len(orders)-1], sel.order)
+				continue
+			}
+		}
+		passages = append(passages, Passage{
+			DocumentID: chunk.DocumentID,
+			Chunks:     []int{sel.pos},
+			Content:    sel.content,
+			Score:      chunk.Score,
+			Truncated:  sel.truncated,
+		})
+		orders = append(orders, sel.order)
+	}
+
+	for i := range passages {
+		passages[i].Tokens = p.tokenizer.Count(passages[i].Content)
+	}
+	idx := make([]int, len(passages))
+	for i := range idx {
+		idx[i] = i
+	}
+	slices.SortFunc(idx, func(a, b int) int {
+		return cmp.Or(cmp.Compare(passages[b].Score, passages[a].Score), cmp.Compare(orders[a], orders[b]))
+	})
+	sorted := make([]Passage, len(passages))
+	for i, j := range idx {
+		sorted[i] = passages[j]
+	}
+	return sorted
+}
+
+// joinOverlapping appends b to a, dropping the start of b that repeats the
+// end of a. Chunkers with an overlap setting produce such repeats.
+func joinOverlapping(a, b string) string {
+	for n := min(len(a), len(b), maxOverlapBytes); n >= minOverlapBytes; n-- {
+		if strings.HasSuffix(a, b[:n]) {
+			return a + b[n:]
+		}
+	}
+	return a + "\n" + b
+}
+
+// sentenceEnds returns the byte offsets just past each sentence in text.
+// Full-width terminators and newlines always end a sentence; ASCII ones
+// only when followed by whitespace or the end of the text, so decimals
+// and abbreviations like "v1.2" stay intact.
+func sentenceEnds(text string) []int {
+	var ends []int
+	for i, r := range text {
+		next := i + utf8.RuneLen(r)
+		switch r {
+		case '。', '！', '？', '；', '\n':
+			ends = append(ends, next)
+		case '.', '!', '?', ';':
+			if next == len(text) {
+				ends = append(ends, next)
+				continue
+			}
+			following, _ := utf8.DecodeRuneInString(text[next:])
+			if unicode.IsSpace(following) {
+				ends = append(ends, next)
+			}
+		}
+	}
+	if len(ends) == 0 || ends[len(ends)-1] != len(text) {
+		ends = append(ends, len(text))
+	}
+	return ends
+}
+
+func runePrefix(text string, n int) string {
+	for i := range text {
+		if n == 0 {
+			return text[:i]
+		}
+		n--
+	}
+	return text
+}
@@ -0,0 +1,222 @@
+package contextpack
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// runeTokenizer counts one token per rune, which keeps budgets easy to
+// reason about.
+var runeTokenizer = TokenizerFunc(utf8.RuneCountInString)
+
+func TestPack(t *testing.T) {
+	type passage struct {
+		content   string
+		chunks    []int
+		truncated bool
+	}
+
+	tests := []struct {
+		name     string
+		budget   int
+		opts     []Option
+		chunks   []Chunk
+		passages []passage
+		dropped  []int
+		tokens   int
+	}{
+		{
+			name:   "everything fits, ordered by score",
+			budget: 100,
+			chunks: []Chunk{
+				{DocumentID: "a", Content: "low score", Score: 1},
+				{DocumentID: "b", Content: "high score", Score: 2},
+			},
+			passages: []passage{
+				{content: "high score", chunks: []int{1}},
+				{content: "low score", chunks: []int{0}},
+			},
+			tokens: 19,
+		},
+		{
+			name:   "overhead counts against the budget",
+			budget: 25,
+			opts:   []Option{WithPassageOverhead(5)},
+			chunks: []Chunk{
+				{DocumentID: "a", Content: "0123456789", Score: 2},
+				{DocumentID: "b", Content: "abcdefghij", Score: 1},
+			},
+			passages: []passage{{content: "0123456789", chunks: []int{0}}},
+			dropped:  []int{1},
+			tokens:   15,
+		},
+		{
+			name:   "trimmed to whole sentences",
+			budget: 32,
+			opts:   []Option{WithMinTrimTokens(5)},
+			chunks: []Chunk{
+				{DocumentID: "a", Content: "一二三四五。六七八九十。", Score: 2},
+				{DocumentID: "b", Content: "Alpha one. Beta two. Gamma three.", Score: 1},
+			},
+			passages: []passage{
+				{content: "一二三四五。六七八九十。", chunks: []int{0}},
+				{content: "Alpha one. Beta two.", chunks: []int{1}, truncated: true},
+			},
+			tokens: 32,
+		},
+		{
+			name:   "decimals are not sentence ends",
+			budget: 20,
+			opts:   []Option{WithMinTrimTokens(5)},
+			chunks: []Chunk{
+				{DocumentID: "a", Content: "Use v1.2 now. Then stop.", Score: 1},
+			},
+			passages: []passage{{content: "Use v1.2 now.", chunks: []int{0}, truncated: true}},
+			tokens:   13,
+		},
+		{
+			name:   "remainder below minimum is dropped",
+			budget: 15,
+			opts:   []Option{WithMinTrimTokens(8)},
+			chunks: []Chunk{
+				{DocumentID: "a", Content: "0123456789", Score: 2},
+				{DocumentID: "b", Content: "Short. Sentences. Here.", Score: 1},
+			},
+			passages: []passage{{content: "0123456789", chunks: []int{0}}},
+			dropped:  []int{1},
+			tokens:   10,
+		},
+		{
+			name:   "later chunk without a fitting sentence is dropped",
+			budget: 20,
+			opts:   []Option{WithMinTrimTokens(3)},
+			chunks: []Chunk{
+				{DocumentID: "a", Content: "0123456789", Score: 2},
+				{DocumentID: "b", Content: "no sentence boundary at all", Score: 1},
+			},
+			passages: []passage{{content: "0123456789", chunks: []int{0}}},
+			dropped:  []int{1},
+			tokens:   10,
+		},
+		{
+			name:   "first chunk is cut mid-sentence",
+			budget: 5,
+			opts:   []Option{WithMinTrimTokens(1)},
+			chunks: []Chunk{
+				{DocumentID: "a", Content: "abcdefghij", Score: 1},
+			},
+			passages: []passage{{content: "abcde", chunks: []int{0}, truncated: true}},
+			tokens:   5,
+		},
+		{
+			name:   "adjacent chunks merge without their overlap",
+			budget: 100,
+			chunks: []Chunk{
+				{DocumentID: "a", Index: 1, Content: "brown fox jumps", Score: 1},
+				{DocumentID: "a", Index: 0, Content: "The quick brown fox", Score: 3},
+				{DocumentID: "b", Index: 0, Content: "other", Score: 2},
+			},
+			passages: []passage{
+				{content: "The quick brown fox jumps", chunks: []int{1, 0}},
+				{content: "other", chunks: []int{2}},
+			},
+			tokens: 39,
+		},
+		{
+			name:   "gaps and truncation prevent merging",
+			budget: 25,
+			opts:   []Option{WithMinTrimTokens(5)},
+			chunks: []Chunk{
+				{DocumentID: "a", Index: 0, Content: "zero", Score: 4},
+				{DocumentID: "a", Index: 2, Content: "two", Score: 3},
+				{DocumentID: "a", Index: 3, Content: "First part. Second part.", Score: 2},
+				{DocumentID: "a", Index: 4, Content: "four", Score: 1},
+			},
+			passages: []passage{
+				{content: "zero", chunks: []int{0}},
+				{content: "two\nFirst part.", chunks: []int{1, 2}, truncated: true},
+				{content: "four", chunks: []int{3}},
+			},
+			tokens: 22,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]Option{WithTokenizer(runeTokenizer)}, tt.opts...)
+			result := New(tt.budget, opts...).Pack(tt.chunks)
+
+			got := make([]passage, len(result.Passages))
+			for i, p := range result.Passages {
+				got[i] = passage{content: p.Content, chunks: p.Chunks, truncated: p.Truncated}
+				if p.Tokens != runeTokenizer.Count(p.Content) {
+					t.Errorf("passage %d tokens = %d, want %d", i, p.Tokens, runeTokenizer.Count(p.Content))
+				}
+			}
+			if !reflect.DeepEqual(got, tt.passages) {
+				t.Errorf("passages = %+v, want %+v", got, tt.passages)
+			}
+			if !reflect.DeepEqual(result.Dropped, tt.dropped) {
+				t.Errorf("dropped = %v, want %v", result.Dropped, tt.dropped)
+			}
+			if result.Tokens != tt.tokens {
+				t.Errorf("tokens = %d, want %d", result.Tokens, tt.tokens)
+			}
+			if result.Tokens > tt.budget {
+				t.Errorf("tokens %d exceed budget %d", result.Tokens, tt.budget)
+			}
+		})
+	}
+}
+
+func TestSentenceEnds(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{text: "", want: []string{""}},
+		{text: "no end", want: []string{"no end"}},
+		{text: "One. Two!", want: []string{"One.", " Two!"}},
+		{text: "版本 v1.2 发布。下一句", want: []string{"版本 v1.2 发布。", "下一句"}},
+		{text: "e.g.x ok\nnext", want: []string{"e.g.x ok\n", "next"}},
+		{text: "问？答！", want: []string{"问？", "答！"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			var got []string
+			start := 0
+			for _, end := range sentenceEnds(tt.text) {
+				got = append(got, tt.text[start:end])
+				start = end
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sentences = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinOverlapping(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{name: "overlap removed", a: "The quick brown fox", b: "brown fox jumps", want: "The quick brown fox jumps"},
+		{name: "short overlap kept", a: "end of it", b: "it goes on", want: "end of it\nit goes on"},
+		{name: "no overlap", a: "first", b: "second", want: "first\nsecond"},
+		{name: "b repeats entirely", a: "prefix and tail text", b: "tail text", want: "prefix and tail text"},
+		{name: "overlap search is bounded", a: "x" + strings.Repeat("ab", 600), b: strings.Repeat("ab", 600) + "y", want: "x" + strings.Repeat("ab", 600) + strings.Repeat("ab", 600-maxOverlapBytes/2) + "y"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := joinOverlapping(tt.a, tt.b); got != tt.want {
+				t.Errorf("joinOverlapping(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
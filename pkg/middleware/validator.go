@@ -10,30 +10,66 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-// HTTPValidator creates a Connect middleware that validates protobuf messages using protovalidate
-func HTTPValidator() connect.UnaryInterceptorFunc {
+// HTTPValidator creates a Connect middleware that validates protobuf messages using protovalidate.
+// Unary requests are validated before the handler runs; streaming handlers have
+// every received message validated.
+func HTTPValidator() connect.Interceptor {
 	validator, err := protovalidate.New()
 	if err != nil {
 		panic(fmt.Sprintf("failed to create protovalidate validator: %v", err))
 	}
+	return &validatorInterceptor{validator: validator}
+}
 
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			msg := req.Any()
-			protoMsg, ok := msg.(proto.Message)
-			if !ok {
-				return next(ctx, req)
-			}
-			if err := validator.Validate(protoMsg); err != nil {
-				var validationError *protovalidate.ValidationError
-				if errors.As(err, &validationError) {
-					return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("validation failed: %s", formatValidationError(validationError)))
-				}
-				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("validation failed: %v", err))
-			}
-			return next(ctx, req)
+type validatorInterceptor struct {
+	validator protovalidate.Validator
+}
+
+func (i *validatorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.validate(req.Any()); err != nil {
+			return nil, err
 		}
+		return next(ctx, req)
+	}
+}
+
+func (i *validatorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *validatorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &validatingHandlerConn{StreamingHandlerConn: conn, interceptor: i})
+	}
+}
+
+func (i *validatorInterceptor) validate(msg any) error {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	if err := i.validator.Validate(protoMsg); err != nil {
+		var validationError *protovalidate.ValidationError
+		if errors.As(err, &validationError) {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("validation failed: %s", formatValidationError(validationError)))
+		}
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("validation failed: %v", err))
+	}
+	return nil
+}
+
+// validatingHandlerConn validates each message received by a streaming handler.
+type validatingHandlerConn struct {
+	connect.StreamingHandlerConn
+	interceptor *validatorInterceptor
+}
+
+func (c *validatingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
 	}
+	return c.interceptor.validate(msg)
 }
 
 func formatValidationError(validationError *protovalidate.ValidationError) string {
@@ -0,0 +1,347 @@
+package parsers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DOCXParser converts Word documents to Markdown.
+//
+// It reads word/document.xml directly: heading styles (including localized
+// style IDs, resolved through word/styles.xml) and outline levels become
+// Markdown headings, numbered paragraphs become list items, bold runs are
+// kept and tables become pipe tables.
+type DOCXParser struct{}
+
+// Name implements DocumentParser.
+func (DOCXParser) Name() string { return "docx" }
+
+// Parse implements DocumentParser.
+func (DOCXParser) Parse(_ context.Context, in Input) (*Document, error) {
+	archive, err := zip.NewReader(bytes.NewReader(in.Data), int64(len(in.Data)))
+	if err != nil {
+		return nil, fmt.Errorf("open docx archive: %w", err)
+	}
+
+	headingStyles, err := docxHeadingStyles(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := openZipFile(archive, "word/document.xml")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	content, err := convertDOCXBody(body, headingStyles)
+	if err != nil {
+		return nil, err
+	}
+	content = normalizeText(content)
+	if strings.TrimSpace(content) == "" {
+		return nil, ErrEmptyDocument
+	}
+
+	return &Document{Content: content, Pages: docxPageCount(archive)}, nil
+}
+
+type docxSegment struct {
+	text string
+	bold bool
+}
+
+type docxParagraph struct {
+	style        string
+	outlineLevel int // 1-based, 0 when unset
+	list         bool
+	listLevel    int
+	segments     []docxSegment
+}
+
+func (p *docxParagraph) write(text string, bold bool) {
+	if text == "" {
+		return
+	}
+	if n := len(p.segments); n > 0 && p.segments[n-1].bold == bold {
+		p.segments[n-1].text += text
+		return
+	}
+	p.segments = append(p.segments, docxSegment{text: text, bold: bold})
+}
+
+func (p *docxParagraph) text() string {
+	var b strings.Builder
+	for _, seg := range p.segments {
+		if seg.bold {
+			b.WriteString(wrapInline(seg.text, "**"))
+		} else {
+			b.WriteString(seg.text)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// markdown renders the paragraph as a block and reports whether it is a
+// list item; inTable keeps it on one line.
+func (p *docxParagraph) markdown(headingStyles map[string]int, inTable bool) (string, bool) {
+	text := p.text()
+	if text == "" {
+		return "", false
+	}
+	if inTable {
+		return strings.Join(strings.Fields(text), " "), false
+	}
+
+	level := headingStyles[p.style]
+	if level == 0 {
+		level = p.outlineLevel
+	}
+	if level > 0 {
+		// Bold markers add nothing to a heading.
+		plain := strings.ReplaceAll(text, "**", "")
+		return strings.Repeat("#", min(level, 6)) + " " + strings.Join(strings.Fields(plain), " "), false
+	}
+	if p.list {
+		return strings.Repeat("  ", p.listLevel) + "- " + text, true
+	}
+	return text, false
+}
+
+type docxTable struct {
+	rows [][]string
+	row  []string
+	cell []string
+}
+
+// convertDOCXBody walks document.xml in order, emitting one Markdown block
+// per paragraph or top-level table.
+func convertDOCXBody(r io.Reader, headingStyles map[string]int) (string, error) {
+	var (
+		blocks    []string
+		tables    []*docxTable
+		para      *docxParagraph
+		inRun     bool
+		runBold   bool
+		inText    bool
+		prevWasLi bool
+	)
+
+	emit := func(block string, isList bool) {
+		if block == "" {
+			return
+		}
+		// Consecutive list items stay in one block.
+		if isList && prevWasLi && len(blocks) > 0 {
+			blocks[len(blocks)-1] += "\n" + block
+		} else {
+			blocks = append(blocks, block)
+		}
+		prevWasLi = isList
+	}
+
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("read docx body: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "tbl":
+				tables = append(tables, &docxTable{})
+			case "tr":
+				if len(tables) > 0 {
+					tables[len(tables)-1].row = nil
+				}
+			case "tc":
+				if len(tables) > 0 {
+					tables[len(tables)-1].cell = nil
+				}
+			case "p":
+				para = &docxParagraph{}
+			case "pStyle":
+				if para != nil {
+					para.style = xmlAttr(t, "val")
+				}
+			case "outlineLvl":
+				if para != nil {
+					if lvl, err := strconv.Atoi(xmlAttr(t, "val")); err == nil && lvl < 9 {
+						para.outlineLevel = lvl + 1
+					}
+				}
+			case "numPr":
+				if para != nil {
+					para.list = true
+				}
+			case "ilvl":
+				if para != nil {
+					para.listLevel, _ = strconv.Atoi(xmlAttr(t, "val"))
+				}
+			case "r":
+				inRun, runBold = true, false
+			case "b":
+				if inRun {
+					val := xmlAttr(t, "val")
+					runBold = val == "" || (val != "0" && val != "false")
+				}
+			case "t":
+				inText = inRun
+			case "tab":
+				if inRun && para != nil {
+					para.write(" ", runBold)
+				}
+			case "br", "cr":
+				if inRun && para != nil {
+					para.write("\n", false)
+				}
+			}
+
+		case xml.CharData:
+			if inText && para != nil {
+				para.write(string(t), runBold)
+			}
+
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "r":
+				inRun = false
+			case "p":
+				if para == nil {
+					continue
+				}
+				if len(tables) > 0 {
+					table := tables[len(tables)-1]
+					if text, _ := para.markdown(headingStyles, true); text != "" {
+						table.cell = append(table.cell, text)
+					}
+				} else {
+					emit(para.markdown(headingStyles, false))
+				}
+				para = nil
+			case "tc":
+				if len(tables) > 0 {
+					table := tables[len(tables)-1]
+					table.row = append(table.row, tableCell(strings.Join(table.cell, " ")))
+				}
+			case "tr":
+				if len(tables) > 0 {
+					table := tables[len(tables)-1]
+					if len(table.row) > 0 {
+						table.rows = append(table.rows, table.row)
+					}
+				}
+			case "tbl":
+				if len(tables) == 0 {
+					continue
+				}
+				table := tables[len(tables)-1]
+				tables = tables[:len(tables)-1]
+				if len(tables) > 0 {
+					// Nested tables are flattened into the enclosing cell.
+					parent := tables[len(tables)-1]
+					for _, row := range table.rows {
+						parent.cell = append(parent.cell, strings.Join(row, " "))
+					}
+				} else {
+					emit(markdownTable(table.rows), false)
+				}
+			}
+		}
+	}
+
+	return strings.Join(blocks, "\n\n"), nil
+}
+
+// docxHeadingStyles maps style IDs to heading levels. Localized Word
+// versions use IDs such as "1" for "heading 1", so the style names are
+// checked as well.
+func docxHeadingStyles(archive *zip.Reader) (map[string]int, error) {
+	levels := make(map[string]int)
+	for i := 1; i <= 9; i++ {
+		levels[fmt.Sprintf("Heading%d", i)] = i
+	}
+	levels["Title"] = 1
+
+	styles, err := openZipFile(archive, "word/styles.xml")
+	if err != nil {
+		// styles.xml is optional.
+		return levels, nil
+	}
+	defer styles.Close()
+
+	var doc struct {
+		Styles []struct {
+			ID   string `xml:"styleId,attr"`
+			Name struct {
+				Val string `xml:"val,attr"`
+			} `xml:"name"`
+		} `xml:"style"`
+	}
+	if err := xml.NewDecoder(styles).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("read docx styles: %w", err)
+	}
+	for _, style := range doc.Styles {
+		name := strings.ToLower(style.Name.Val)
+		if name == "title" {
+			levels[style.ID] = 1
+			continue
+		}
+		if rest, ok := strings.CutPrefix(name, "heading "); ok {
+			if lvl, err := strconv.Atoi(rest); err == nil && lvl > 0 {
+				levels[style.ID] = lvl
+			}
+		}
+	}
+	return levels, nil
+}
+
+// docxPageCount reads the page count Word stores in docProps/app.xml; it
+// is zero when missing.
+func docxPageCount(archive *zip.Reader) int {
+	app, err := openZipFile(archive, "docProps/app.xml")
+	if err != nil {
+		return 0
+	}
+	defer app.Close()
+
+	var props struct {
+		Pages int `xml:"Pages"`
+	}
+	if err := xml.NewDecoder(app).Decode(&props); err != nil {
+		return 0
+	}
+	return props.Pages
+}
+
+func openZipFile(archive *zip.Reader, name string) (io.ReadCloser, error) {
+	for _, f := range archive.File {
+		if f.Name == name {
+			return f.Open()
+		}
+	}
+	return nil, fmt.Errorf("docx archive has no %s", name)
+}
+
+func xmlAttr(el xml.StartElement, local string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,111 @@
+package parsers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+)
+
+const docxNS = `xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"`
+
+// buildDOCX zips the given parts into a minimal Word archive.
+func buildDOCX(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func docxBody(body string) string {
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?><w:document ` + docxNS + `><w:body>` + body + `</w:body></w:document>`
+}
+
+func TestDOCXParser(t *testing.T) {
+	tests := []struct {
+		name    string
+		parts   map[string]string
+		want    string
+		pages   int
+		wantErr error
+	}{
+		{
+			name: "headings, bold and lists",
+			parts: map[string]string{
+				"word/document.xml": docxBody(`
+<w:p><w:pPr><w:pStyle w:val="Title"/></w:pPr><w:r><w:t>Report</w:t></w:r></w:p>
+<w:p><w:pPr><w:pStyle w:val="Heading2"/></w:pPr><w:r><w:rPr><w:b/></w:rPr><w:t>Scope</w:t></w:r></w:p>
+<w:p><w:r><w:t xml:space="preserve">Plain </w:t></w:r><w:r><w:rPr><w:b/></w:rPr><w:t>bold</w:t></w:r><w:r><w:rPr><w:b w:val="0"/></w:rPr><w:t xml:space="preserve"> text</w:t></w:r></w:p>
+<w:p><w:pPr><w:numPr><w:ilvl w:val="0"/></w:numPr></w:pPr><w:r><w:t>one</w:t></w:r></w:p>
+<w:p><w:pPr><w:numPr><w:ilvl w:val="1"/></w:numPr></w:pPr><w:r><w:t>nested</w:t></w:r></w:p>
+<w:p><w:pPr><w:outlineLvl w:val="2"/></w:pPr><w:r><w:t>Outline</w:t></w:r></w:p>`),
+				"docProps/app.xml": `<Properties><Pages>3</Pages></Properties>`,
+			},
+			want:  "# Report\n\n## Scope\n\nPlain **bold** text\n\n- one\n  - nested\n\n### Outline",
+			pages: 3,
+		},
+		{
+			name: "localized heading style",
+			parts: map[string]string{
+				"word/document.xml": docxBody(`<w:p><w:pPr><w:pStyle w:val="1"/></w:pPr><w:r><w:t>标题</w:t></w:r></w:p><w:p><w:r><w:t>正文</w:t></w:r></w:p>`),
+				"word/styles.xml":   `<w:styles ` + docxNS + `><w:style w:styleId="1"><w:name w:val="heading 1"/></w:style></w:styles>`,
+			},
+			want: "# 标题\n\n正文",
+		},
+		{
+			name: "table",
+			parts: map[string]string{
+				"word/document.xml": docxBody(`<w:tbl>
+<w:tr><w:tc><w:p><w:r><w:t>a</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>b</w:t></w:r></w:p></w:tc></w:tr>
+<w:tr><w:tc><w:p><w:r><w:t>1</w:t></w:r></w:p><w:p><w:r><w:t>2</w:t></w:r></w:p></w:tc><w:tc/></w:tr>
+</w:tbl>`),
+			},
+			want: "| a | b |\n| --- | --- |\n| 1 2 |  |",
+		},
+		{
+			name:    "empty body",
+			parts:   map[string]string{"word/document.xml": docxBody(`<w:p/>`)},
+			wantErr: ErrEmptyDocument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := DOCXParser{}.Parse(context.Background(), Input{Filename: "doc.docx", Data: buildDOCX(t, tt.parts)})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if doc.Content != tt.want {
+				t.Errorf("content = %q, want %q", doc.Content, tt.want)
+			}
+			if doc.Pages != tt.pages {
+				t.Errorf("pages = %d, want %d", doc.Pages, tt.pages)
+			}
+		})
+	}
+
+	t.Run("not a zip archive", func(t *testing.T) {
+		if _, err := (DOCXParser{}).Parse(context.Background(), Input{Data: []byte("plain text")}); err == nil {
+			t.Fatal("want an error for a non-zip file")
+		}
+	})
+}
@@ -0,0 +1,334 @@
+package parsers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/net/html/charset"
+)
+
+var whitespaceRun = regexp.MustCompile(`\s+`)
+
+// HTMLParser converts HTML pages, such as wiki exports, to Markdown.
+//
+// Headings, paragraphs, lists, tables, code blocks, quotes, links and
+// emphasis are kept; scripts, styles and other non-content elements are
+// dropped.
+type HTMLParser struct{}
+
+// Name implements DocumentParser.
+func (HTMLParser) Name() string { return "html" }
+
+// Parse implements DocumentParser.
+func (HTMLParser) Parse(_ context.Context, in Input) (*Document, error) {
+	reader, err := charset.NewReader(bytes.NewReader(in.Data), in.ContentType)
+	if err != nil {
+		return nil, fmt.Errorf("detect html charset: %w", err)
+	}
+	root, err := html.Parse(reader)
+	if err != nil {
+		return nil, fmt.Errorf("parse html: %w", err)
+	}
+
+	content := normalizeText(htmlBlocks(root))
+	if strings.TrimSpace(content) == "" {
+		return nil, ErrEmptyDocument
+	}
+	return &Document{Content: content}, nil
+}
+
+// htmlBlocks renders the children of n as Markdown blocks separated by
+// blank lines. Runs of inline children become one paragraph.
+func htmlBlocks(n *html.Node) string {
+	var (
+		parts  []string
+		inline strings.Builder
+	)
+	flush := func() {
+		if p := tidyParagraph(inline.String()); p != "" {
+			parts = append(parts, p)
+		}
+		inline.Reset()
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && isHTMLBlock(c.DataAtom) {
+			flush()
+			if md := htmlBlock(c); md != "" {
+				parts = append(parts, md)
+			}
+			continue
+		}
+		inline.WriteString(htmlInline(c))
+	}
+	flush()
+
+	return strings.Join(parts, "\n\n")
+}
+
+func htmlBlock(n *html.Node) string {
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level := int(n.Data[1] - '0')
+		text := strings.Join(strings.Fields(htmlInlineChildren(n)), " ")
+		if text == "" {
+			return ""
+		}
+		return strings.Repeat("#", level) + " " + text
+	case atom.Ul, atom.Ol:
+		return htmlList(n)
+	case atom.Pre:
+		return htmlPre(n)
+	case atom.Blockquote:
+		return prefixLines(htmlBlocks(n), "> ")
+	case atom.Table:
+		return htmlTable(n)
+	case atom.Hr:
+		return "---"
+	case atom.Head, atom.Script, atom.Style, atom.Noscript, atom.Template,
+		atom.Iframe, atom.Svg, atom.Canvas, atom.Form, atom.Button, atom.Select:
+		return ""
+	default:
+		// html, body, div, section, p, li content and other containers.
+		return htmlBlocks(n)
+	}
+}
+
+func isHTMLBlock(a atom.Atom) bool {
+	switch a {
+	case atom.Html, atom.Head, atom.Body, atom.Div, atom.P, atom.Section, atom.Article,
+		atom.Main, atom.Header, atom.Footer, atom.Nav, atom.Aside, atom.Figure, atom.Figcaption,
+		atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6,
+		atom.Ul, atom.Ol, atom.Li, atom.Dl, atom.Dt, atom.Dd,
+		atom.Pre, atom.Blockquote, atom.Table, atom.Hr, atom.Details, atom.Summary,
+		atom.Script, atom.Style, atom.Noscript, atom.Template, atom.Iframe, atom.Svg,
+		atom.Canvas, atom.Form:
+		return true
+	}
+	return false
+}
+
+func htmlInline(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return whitespaceRun.ReplaceAllString(n.Data, " ")
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	switch n.DataAtom {
+	case atom.Br:
+		return "\n"
+	case atom.Strong, atom.B:
+		return wrapInline(htmlInlineChildren(n), "**")
+	case atom.Em, atom.I:
+		return wrapInline(htmlInlineChildren(n), "*")
+	case atom.Code, atom.Kbd, atom.Samp:
+		return wrapInline(textContent(n), "`")
+	case atom.A:
+		text := htmlInlineChildren(n)
+		href := attr(n, "href")
+		if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(href, "javascript:") || strings.TrimSpace(text) == "" {
+			return text
+		}
+		return fmt.Sprintf("[%s](%s)", strings.TrimSpace(text), href)
+	case atom.Img:
+		src := attr(n, "src")
+		if src == "" || strings.HasPrefix(src, "data:") {
+			return attr(n, "alt")
+		}
+		return fmt.Sprintf("![%s](%s)", attr(n, "alt"), src)
+	case atom.Script, atom.Style, atom.Noscript, atom.Template:
+		return ""
+	default:
+		return htmlInlineChildren(n)
+	}
+}
+
+func htmlInlineChildren(n *html.Node) string {
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(htmlInline(c))
+	}
+	return b.String()
+}
+
+// wrapInline surrounds the trimmed text with marker, keeping the outer
+// spaces outside the markers so Markdown still recognizes them.
+func wrapInline(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	lead := text[:strings.Index(text, trimmed)]
+	trail := text[len(lead)+len(trimmed):]
+	return lead + marker + trimmed + marker + trail
+}
+
+func htmlList(n *html.Node) string {
+	ordered := n.DataAtom == atom.Ol
+	var items []string
+	index := 1
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || c.DataAtom != atom.Li {
+			continue
+		}
+		marker := "- "
+		if ordered {
+			marker = fmt.Sprintf("%d. ", index)
+			index++
+		}
+		body := htmlBlocks(c)
+		if body == "" {
+			continue
+		}
+		items = append(items, marker+indentFollowingLines(body, strings.Repeat(" ", len(marker))))
+	}
+	return strings.Join(items, "\n")
+}
+
+func htmlPre(n *html.Node) string {
+	lang := ""
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.DataAtom == atom.Code {
+			for _, class := range strings.Fields(attr(c, "class")) {
+				if l, ok := strings.CutPrefix(class, "language-"); ok {
+					lang = l
+				}
+			}
+		}
+	}
+	code := strings.Trim(textContent(n), "\n")
+	if strings.TrimSpace(code) == "" {
+		return ""
+	}
+	return "```" + lang + "\n" + code + "\n```"
+}
+
+func htmlTable(n *html.Node) string {
+	var rows [][]string
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		for c := node.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			switch c.DataAtom {
+			case atom.Tr:
+				var cells []string
+				for cell := c.FirstChild; cell != nil; cell = cell.NextSibling {
+					if cell.Type == html.ElementNode && (cell.DataAtom == atom.Td || cell.DataAtom == atom.Th) {
+						cells = append(cells, tableCell(textOf(cell)))
+					}
+				}
+				if len(cells) > 0 {
+					rows = append(rows, cells)
+				}
+			case atom.Thead, atom.Tbody, atom.Tfoot:
+				walk(c)
+			}
+		}
+	}
+	walk(n)
+	return markdownTable(rows)
+}
+
+// textOf renders n's inline content on a single line.
+func textOf(n *html.Node) string {
+	return strings.Join(strings.Fields(htmlInlineChildren(n)), " ")
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(textContent(c))
+	}
+	return b.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return strings.TrimSpace(a.Val)
+		}
+	}
+	return ""
+}
+
+// tidyParagraph trims every line of an inline run and drops empty ones.
+func tidyParagraph(s string) string {
+	lines := strings.Split(s, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
+
+func tableCell(s string) string {
+	return strings.ReplaceAll(strings.TrimSpace(s), "|", `\|`)
+}
+
+// markdownTable renders rows as a pipe table, treating the first row as the
+// header and padding short rows.
+func markdownTable(rows [][]string) string {
+	if len(rows) == 0 {
+		return ""
+	}
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+
+	var b strings.Builder
+	writeRow := func(row []string) {
+		b.WriteString("|")
+		for i := range width {
+			cell := ""
+			if i < len(row) {
+				cell = row[i]
+			}
+			b.WriteString(" " + cell + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	writeRow(rows[0])
+	b.WriteString("|" + strings.Repeat(" --- |", width) + "\n")
+	for _, row := range rows[1:] {
+		writeRow(row)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func prefixLines(s, prefix string) string {
+	if s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(prefix+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func indentFollowingLines(s, indent string) string {
+	lines := strings.Split(s, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = indent + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,64 @@
+package parsers
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestHTMLParser(t *testing.T) {
+	tests := []struct {
+		name    string
+		html    string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "headings and paragraphs",
+			html: `<html><head><title>t</title><style>p{}</style></head><body>
+<h1>Title</h1>
+<p>First  <b>bold</b> and <em>em</em>.</p>
+<div>Line one<br>line two</div>
+</body></html>`,
+			want: "# Title\n\nFirst **bold** and *em*.\n\nLine one\nline two",
+		},
+		{
+			name: "lists and links",
+			html: `<ul><li>one</li><li><a href="https://example.com">two</a></li></ul><ol><li>first</li><li>second<ul><li>nested</li></ul></li></ol>`,
+			want: "- one\n- [two](https://example.com)\n\n1. first\n2. second\n\n   - nested",
+		},
+		{
+			name: "table, code and quote",
+			html: `<table><tr><th>a</th><th>b|c</th></tr><tr><td>1</td></tr></table><pre><code class="language-go">x := 1</code></pre><blockquote><p>quoted</p></blockquote><p>use <code>go test</code></p>`,
+			want: "| a | b\\|c |\n| --- | --- |\n| 1 |  |\n\n```go\nx := 1\n```\n\n> quoted\n\nuse `go test`",
+		},
+		{
+			name: "scripts dropped",
+			html: `<body><script>alert(1)</script><noscript>enable js</noscript>kept</body>`,
+			want: "kept",
+		},
+		{
+			name:    "no text",
+			html:    `<html><body><script>x()</script></body></html>`,
+			wantErr: ErrEmptyDocument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := HTMLParser{}.Parse(context.Background(), Input{Filename: "page.html", ContentType: "text/html; charset=utf-8", Data: []byte(tt.html)})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if doc.Content != tt.want {
+				t.Errorf("content = %q, want %q", doc.Content, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package parsers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Page markers are HTML comments, so they survive blank-line cleanup and
+// Markdown parsing treats them as non-content blocks.
+var (
+	pageMarkerPattern     = regexp.MustCompile(`<!-- page: (\d+) -->`)
+	pageMarkerLinePattern = regexp.MustCompile(`\n*[ \t]*<!-- page: \d+ -->[ \t]*\n*`)
+)
+
+// PageMark is a page marker found in a document's content.
+type PageMark struct {
+	// Page is the 1-based page number the marker starts.
+	Page int
+	// Start and End are the marker's byte offsets.
+	Start, End int
+}
+
+// PageMarker returns the marker that starts page (1-based) in Content.
+func PageMarker(page int) string {
+	return "<!-- page: " + strconv.Itoa(page) + " -->"
+}
+
+// JoinPages joins per-page texts into one document, starting each
+// non-empty page with its page marker on a line of its own.
+func JoinPages(pages []string) string {
+	var b strings.Builder
+	for i, page := range pages {
+		if strings.TrimSpace(page) == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(PageMarker(i + 1))
+		b.WriteString("\n\n")
+		b.WriteString(page)
+	}
+	return b.String()
+}
+
+// FindPageMarkers returns the page markers in s in order of appearance.
+func FindPageMarkers(s string) []PageMark {
+	matches := pageMarkerPattern.FindAllStringSubmatchIndex(s, -1)
+	marks := make([]PageMark, 0, len(matches))
+	for _, m := range matches {
+		page, err := strconv.Atoi(s[m[2]:m[3]])
+		if err != nil || page <= 0 {
+			continue
+		}
+		marks = append(marks, PageMark{Page: page, Start: m[0], End: m[1]})
+	}
+	return marks
+}
+
+// StripPageMarkers removes page markers from s.
+func StripPageMarkers(s string) string {
+	if !strings.Contains(s, "<!-- page:") {
+		return s
+	}
+	return strings.TrimSpace(pageMarkerLinePattern.ReplaceAllString(s, "\n\n"))
+}
@@ -0,0 +1,125 @@
+// Package parsers converts uploaded documents into Markdown or plain text
+// ready for chunking.
+package parsers
+
+import (
+	"context"
+	"errors"
+	"mime"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Common errors.
+var (
+	ErrUnsupportedType = errors.New("unsupported document type")
+	ErrEmptyDocument   = errors.New("document is empty")
+)
+
+// Input is a document to parse.
+type Input struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+	// OnProgress, when non-nil, receives parse progress (0-100) from
+	// parsers that can report it.
+	OnProgress func(progress int)
+}
+
+// Document is the parse result.
+type Document struct {
+	// Content is Markdown, or plain text for formats without structure.
+	// Paged formats start each page with a PageMarker.
+	Content string
+	// Pages is the page count when the format has one, otherwise zero.
+	Pages int
+	// PageTexts holds the text of each page when the parser extracts pages
+	// separately; it is nil otherwise.
+	PageTexts []string
+	// Extractor names what actually produced Content when a parser
+	// delegates, such as "doc2x" or "local_pdf". Empty means the parser
+	// itself.
+	Extractor string
+}
+
+// DocumentParser turns one document format into text.
+type DocumentParser interface {
+	// Name identifies the parser in document metadata.
+	Name() string
+	Parse(ctx context.Context, in Input) (*Document, error)
+}
+
+// Registry maps file extensions and MIME types to parsers.
+type Registry struct {
+	mu          sync.RWMutex
+	byExtension map[string]DocumentParser
+	byMIMEType  map[string]DocumentParser
+}
+
+// NewRegistry returns a registry with the built-in Markdown, text, HTML and
+// DOCX parsers. PDF support is registered by the caller, which decides
+// between Doc2X and LocalPDFParser.
+func NewRegistry() *Registry {
+	r := &Registry{
+		byExtension: make(map[string]DocumentParser),
+		byMIMEType:  make(map[string]DocumentParser),
+	}
+	r.Register(TextParser{},
+		[]string{".md", ".markdown", ".txt", ".text"},
+		[]string{"text/markdown", "text/x-markdown", "text/plain"})
+	r.Register(HTMLParser{},
+		[]string{".html", ".htm"},
+		[]string{"text/html", "application/xhtml+xml"})
+	r.Register(DOCXParser{},
+		[]string{".docx"},
+		[]string{"application/vnd.openxmlformats-officedocument.wordprocessingml.document"})
+	return r
+}
+
+// Register adds p for the given extensions (with leading dot) and MIME
+// types, replacing earlier registrations.
+func (r *Registry) Register(p DocumentParser, extensions, mimeTypes []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, ext := range extensions {
+		r.byExtension[strings.ToLower(ext)] = p
+	}
+	for _, mt := range mimeTypes {
+		r.byMIMEType[strings.ToLower(mt)] = p
+	}
+}
+
+// Lookup finds the parser for a file. The extension wins over the MIME
+// type, since browsers often report generic types such as
+// application/octet-stream.
+func (r *Registry) Lookup(filename, contentType string) (DocumentParser, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if p, ok := r.byExtension[strings.ToLower(filepath.Ext(filename))]; ok {
+		return p, nil
+	}
+	if contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err == nil {
+			if p, ok := r.byMIMEType[strings.ToLower(mediaType)]; ok {
+				return p, nil
+			}
+		}
+	}
+	return nil, ErrUnsupportedType
+}
+
+// Extensions lists the registered extensions.
+func (r *Registry) Extensions() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	exts := make([]string, 0, len(r.byExtension))
+	for ext := range r.byExtension {
+		exts = append(exts, ext)
+	}
+	return exts
+}
@@ -0,0 +1,77 @@
+package parsers
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRegistryLookup(t *testing.T) {
+	tests := []struct {
+		name        string
+		filename    string
+		contentType string
+		want        string
+		wantErr     bool
+	}{
+		{name: "by extension", filename: "notes.md", want: "text"},
+		{name: "extension is case-insensitive", filename: "Report.DOCX", want: "docx"},
+		{name: "extension wins over content type", filename: "page.htm", contentType: "text/plain", want: "html"},
+		{name: "content type fallback", filename: "upload", contentType: "text/html; charset=utf-8", want: "html"},
+		{name: "unknown extension falls back", filename: "export.bin", contentType: "Application/XHTML+XML", want: "html"},
+		{name: "generic content type", filename: "data.bin", contentType: "application/octet-stream", wantErr: true},
+		{name: "malformed content type", filename: "data", contentType: "text/html;;", wantErr: true},
+		{name: "nothing to go on", filename: "data", wantErr: true},
+		{name: "pdf is registered by the caller", filename: "paper.pdf", contentType: "application/pdf", wantErr: true},
+	}
+
+	registry := NewRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := registry.Lookup(tt.filename, tt.contentType)
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnsupportedType) {
+					t.Fatalf("err = %v, want ErrUnsupportedType", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Name() != tt.want {
+				t.Errorf("parser = %s, want %s", p.Name(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(LocalPDFParser{}, []string{".PDF"}, []string{"Application/PDF"})
+
+	for _, lookup := range [][2]string{{"paper.pdf", ""}, {"paper", "application/pdf"}} {
+		p, err := registry.Lookup(lookup[0], lookup[1])
+		if err != nil || p.Name() != "local_pdf" {
+			t.Errorf("Lookup(%q, %q) = %v, %v, want local_pdf", lookup[0], lookup[1], p, err)
+		}
+	}
+
+	registry.Register(TextParser{}, []string{".html"}, nil)
+	if p, _ := registry.Lookup("page.html", ""); p.Name() != "text" {
+		t.Errorf("re-registered .html parser = %s, want text", p.Name())
+	}
+}
+
+func TestTextParser(t *testing.T) {
+	doc, err := TextParser{}.Parse(context.Background(), Input{Data: []byte("\xEF\xBB\xBF# 标题\r\nline\rend\xff")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "# 标题\nline\nend"; doc.Content != want {
+		t.Errorf("content = %q, want %q", doc.Content, want)
+	}
+
+	if _, err := (TextParser{}).Parse(context.Background(), Input{Data: []byte(" \r\n\t")}); !errors.Is(err, ErrEmptyDocument) {
+		t.Errorf("err = %v, want ErrEmptyDocument", err)
+	}
+}
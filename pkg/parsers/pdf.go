@@ -0,0 +1,77 @@
+package parsers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// LocalPDFParser extracts the text layer of PDFs in pure Go, without any
+// external service.
+//
+// It keeps no layout beyond line breaks, and scanned PDFs without a text
+// layer come out empty, so it is meant as an offline stand-in for Doc2X
+// rather than a replacement.
+type LocalPDFParser struct{}
+
+// Name implements DocumentParser.
+func (LocalPDFParser) Name() string { return "local_pdf" }
+
+// Parse implements DocumentParser.
+func (LocalPDFParser) Parse(ctx context.Context, in Input) (doc *Document, err error) {
+	// The PDF reader panics on some malformed files.
+	defer func() {
+		if r := recover(); r != nil {
+			doc, err = nil, fmt.Errorf("read pdf: %v", r)
+		}
+	}()
+
+	reader, err := pdf.NewReader(bytes.NewReader(in.Data), int64(len(in.Data)))
+	if err != nil {
+		return nil, fmt.Errorf("open pdf: %w", err)
+	}
+
+	numPages := reader.NumPage()
+	pageTexts := make([]string, numPages)
+	for i := range numPages {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		page := reader.Page(i + 1)
+		if page.V.IsNull() {
+			continue
+		}
+		text, err := page.GetPlainText(nil)
+		if err != nil {
+			return nil, fmt.Errorf("extract text from page %d: %w", i+1, err)
+		}
+		pageTexts[i] = tidyPDFText(text)
+
+		if in.OnProgress != nil {
+			in.OnProgress((i + 1) * 100 / numPages)
+		}
+	}
+
+	content := JoinPages(pageTexts)
+	if strings.TrimSpace(content) == "" {
+		return nil, ErrEmptyDocument
+	}
+	return &Document{Content: content, Pages: numPages, PageTexts: pageTexts}, nil
+}
+
+// tidyPDFText normalizes extracted text and drops the blank lines left
+// between text objects.
+func tidyPDFText(s string) string {
+	lines := strings.Split(normalizeText(s), "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if line = strings.TrimRight(line, " \t"); strings.TrimSpace(line) != "" {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
@@ -0,0 +1,34 @@
+package parsers
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/hsn0918/rag/pkg/utils"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// TextParser passes Markdown and plain text through, normalizing encoding
+// and line endings.
+type TextParser struct{}
+
+// Name implements DocumentParser.
+func (TextParser) Name() string { return "text" }
+
+// Parse implements DocumentParser.
+func (TextParser) Parse(_ context.Context, in Input) (*Document, error) {
+	content := normalizeText(string(bytes.TrimPrefix(in.Data, utf8BOM)))
+	if strings.TrimSpace(content) == "" {
+		return nil, ErrEmptyDocument
+	}
+	return &Document{Content: content}, nil
+}
+
+// normalizeText drops invalid UTF-8 and converts CRLF/CR to LF.
+func normalizeText(s string) string {
+	s = utils.SanitizeUTF8(s)
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\r", "\n")
+}
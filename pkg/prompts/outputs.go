@@ -0,0 +1,50 @@
+package prompts
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxRewrittenQueryRunes bounds a rewritten query like the request limit
+// on queries.
+const maxRewrittenQueryRunes = 2000
+
+// KeywordsOutput is the structured output of the keyword extraction prompt.
+type KeywordsOutput struct {
+	Keywords []string `json:"keywords"`
+}
+
+// Validate trims the keywords, drops blank and single-byte ones and
+// requires at least one to remain.
+func (o *KeywordsOutput) Validate() error {
+	keywords := o.Keywords[:0]
+	for _, keyword := range o.Keywords {
+		if keyword = strings.TrimSpace(keyword); len(keyword) > 1 {
+			keywords = append(keywords, keyword)
+		}
+	}
+	o.Keywords = keywords
+	if len(o.Keywords) == 0 {
+		return errors.New("no keywords")
+	}
+	return nil
+}
+
+// QueryRewriteOutput is the structured output of the query rewrite prompt.
+type QueryRewriteOutput struct {
+	Query string `json:"query"`
+}
+
+// Validate trims the query and requires it to be non-empty and no longer
+// than a request query.
+func (o *QueryRewriteOutput) Validate() error {
+	o.Query = strings.TrimSpace(o.Query)
+	switch {
+	case o.Query == "":
+		return errors.New("empty query")
+	case utf8.RuneCountInString(o.Query) > maxRewrittenQueryRunes:
+		return errors.New("query too long")
+	}
+	return nil
+}
@@ -15,6 +15,8 @@ const (
 	PromptTypeContextSummary PromptType = "context_summary"
 	// PromptTypeRAGResponse is for generating RAG responses.
 	PromptTypeRAGResponse PromptType = "rag_response"
+	// PromptTypeQueryRewrite is for turning follow-up questions into standalone queries.
+	PromptTypeQueryRewrite PromptType = "query_rewrite"
 )
 
 // Prompt represents a reusable prompt template.
@@ -23,10 +25,26 @@ type Prompt struct {
 	Name         string
 	System       string
 	UserTemplate string
+	// Output declares the JSON output used with providers that support
+	// structured output; nil means the prompt only has a text format.
+	Output *OutputSchema
 	// Embedding can store pre-computed embeddings for prompt similarity matching
 	Embedding []float32
 }
 
+// OutputSchema declares the JSON output of a prompt.
+type OutputSchema struct {
+	// Name identifies the schema in json_schema response formats.
+	Name string
+	// Schema is the JSON Schema of the output. It follows the strict
+	// subset: every property required, no additional properties.
+	Schema map[string]any
+	// System replaces Prompt.System in structured mode. It describes the
+	// JSON output instead of the text format and mentions JSON, which
+	// json_object response formats require.
+	System string
+}
+
 // PromptManager manages all prompts and their embeddings.
 type PromptManager struct {
 	prompts map[PromptType]*Prompt
@@ -80,7 +98,34 @@ func (pm *PromptManager) initializeDefaultPrompts() {
 		UserTemplate: `用户查询：
 "{{query}}"
 
-请根据系统指令，提取上述查询的核心关键词。严格以 XML 格式返回结果。`,
+请根据系统指令，提取上述查询的核心关键词。严格按系统指令规定的格式返回结果。`,
+		Output: &OutputSchema{
+			Name: "keywords",
+			Schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"keywords": map[string]any{
+						"type":        "array",
+						"description": "3 到 7 个核心关键词",
+						"items":       map[string]any{"type": "string"},
+					},
+				},
+				"required":             []string{"keywords"},
+				"additionalProperties": false,
+			},
+			System: `你是一个精通信息检索和自然语言处理的中文关键词提取引擎。你的唯一任务是从用户查询中精准地抽取出核心关键词。
+
+核心指令：
+1.  **目标**：提取 3 到 7 个最能代表查询意图的名词性、实体性或主题性关键词。
+2.  **内容**：优先提取专业术语、产品名称、人名、地名等实体名词。
+3.  **过滤**：必须忽略所有通用停用词（如：“的”、“了”、“是”、“一个”、“怎么样”、“请问”等）和无实际意义的动词或形容词。
+4.  **格式**：输出必须是一个 JSON 对象，形如 {"keywords": ["关键词1", "关键词2"]}。不要包含任何其他字段、注释或解释。
+
+示例：
+输入："从上海到北京的高铁票价是多少？"
+输出：
+{"keywords": ["上海", "北京", "高铁", "票价"]}`,
+		},
 	}
 
 	// Context summary prompt
@@ -146,6 +191,62 @@ func (pm *PromptManager) initializeDefaultPrompts() {
 
 任务：请严格遵循系统定义的核心指令和 XML 结构，对上述上下文信息进行处理。确保所有输出都基于提供的信息，并保持绝对中立。`,
 	}
+
+	// Query rewrite prompt
+	pm.prompts[PromptTypeQueryRewrite] = &Prompt{
+		Type: PromptTypeQueryRewrite,
+		Name: "query_rewrite_zh_v1",
+		System: `你是一个多轮对话中的查询改写引擎。你的唯一任务是结合对话历史，把用户最新的问题改写为一个无需上下文即可理解的独立检索查询。
+
+核心指令：
+1.  **补全指代**：将“它”、“这个”、“上面提到的”等指代词替换为对话历史中对应的具体实体或主题。
+2.  **保留意图**：不得改变用户的提问意图，不得回答问题，不得添加历史中不存在的信息。
+3.  **保持简洁**：如果最新问题本身已经完整独立，原样返回。
+4.  **格式**：输出必须是 <query>改写后的查询</query>，不要包含任何其他字符、注释或解释。
+
+示例：
+对话历史：
+用户：pgvector 支持哪些索引类型？
+助手：pgvector 支持 HNSW 和 IVFFlat 两种索引。
+最新问题："它们的构建速度谁更快？"
+输出：
+<query>pgvector 的 HNSW 和 IVFFlat 索引构建速度对比</query>`,
+		UserTemplate: `对话历史：
+{{history}}
+
+最新问题："{{query}}"
+
+请根据系统指令，输出改写后的独立查询。`,
+		Output: &OutputSchema{
+			Name: "query_rewrite",
+			Schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"query": map[string]any{
+						"type":        "string",
+						"description": "改写后的独立检索查询",
+					},
+				},
+				"required":             []string{"query"},
+				"additionalProperties": false,
+			},
+			System: `你是一个多轮对话中的查询改写引擎。你的唯一任务是结合对话历史，把用户最新的问题改写为一个无需上下文即可理解的独立检索查询。
+
+核心指令：
+1.  **补全指代**：将“它”、“这个”、“上面提到的”等指代词替换为对话历史中对应的具体实体或主题。
+2.  **保留意图**：不得改变用户的提问意图，不得回答问题，不得添加历史中不存在的信息。
+3.  **保持简洁**：如果最新问题本身已经完整独立，原样返回。
+4.  **格式**：输出必须是一个 JSON 对象，形如 {"query": "改写后的查询"}，不要包含任何其他字段、注释或解释。
+
+示例：
+对话历史：
+用户：pgvector 支持哪些索引类型？
+助手：pgvector 支持 HNSW 和 IVFFlat 两种索引。
+最新问题："它们的构建速度谁更快？"
+输出：
+{"query": "pgvector 的 HNSW 和 IVFFlat 索引构建速度对比"}`,
+		},
+	}
 }
 
 // GetPrompt returns a prompt by type.
@@ -0,0 +1,233 @@
+package prompts
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	ragResponseOpen  = "<rag_response"
+	ragResponseClose = "</rag_response>"
+)
+
+var (
+	// ErrNoRAGResponse is returned when the text contains no <rag_response>
+	// document.
+	ErrNoRAGResponse = errors.New("no <rag_response> document found")
+	// ErrIncompleteRAGResponse is returned for a document without a summary.
+	ErrIncompleteRAGResponse = errors.New("rag_response has no summary")
+)
+
+// RAGResponse is the document the context summary prompt asks the LLM for.
+type RAGResponse struct {
+	XMLName      xml.Name        `xml:"rag_response"`
+	Summary      ragSummary      `xml:"summary"`
+	InfoPoints   []RAGPoint      `xml:"main_content>info_points>point"`
+	Sections     []RAGPoint      `xml:"detailed_content>section"`
+	KeyPoints    []string        `xml:"key_points>point"`
+	Completeness RAGCompleteness `xml:"completeness"`
+	Sources      []RAGSource     `xml:"sources>source"`
+	// Repaired reports that the document was malformed and only parsed
+	// after a repair pass.
+	Repaired bool `xml:"-"`
+}
+
+// RAGPoint is a titled piece of content, used for info points and sections.
+type RAGPoint struct {
+	Title   string `xml:"title"`
+	Content string `xml:"content"`
+}
+
+// RAGCompleteness is the LLM's assessment of how well the context covers
+// the query.
+type RAGCompleteness struct {
+	Assessment  string `xml:"assessment"`
+	MissingInfo string `xml:"missing_info"`
+}
+
+// RAGSource references a context passage the response draws on.
+type RAGSource struct {
+	ID         string   `xml:"id"`
+	Similarity ragScore `xml:"similarity"`
+	Summary    string   `xml:"summary"`
+}
+
+// ragSummary accepts both <summary><text>…</text></summary> and a bare
+// <summary>…</summary>.
+type ragSummary struct {
+	Text string `xml:"text"`
+	Body string `xml:",chardata"`
+}
+
+// ragScore parses the first number in the element, so answers like
+// "0.89（高）" still yield a score. Text without a number yields 0.
+type ragScore float64
+
+var scorePattern = regexp.MustCompile(`\d+(?:\.\d+)?`)
+
+func (s *ragScore) UnmarshalText(text []byte) error {
+	v, _ := strconv.ParseFloat(scorePattern.FindString(string(text)), 64)
+	*s = ragScore(v)
+	return nil
+}
+
+// SummaryText returns the one-sentence summary.
+func (r *RAGResponse) SummaryText() string {
+	if text := strings.TrimSpace(r.Summary.Text); text != "" {
+		return text
+	}
+	return strings.TrimSpace(r.Summary.Body)
+}
+
+// Score returns the similarity the source was cited with.
+func (s RAGSource) Score() float64 { return float64(s.Similarity) }
+
+// ParseRAGResponse decodes the <rag_response> document in text. Text around
+// the document, such as code fences or a trailing note, is ignored.
+//
+// Malformed documents get a repair pass that escapes stray '&' and '<',
+// rewrites mismatched end tags and closes elements left open by a
+// truncated answer; Repaired is set when it was needed.
+func ParseRAGResponse(text string) (*RAGResponse, error) {
+	doc, err := extractRAGResponse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp RAGResponse
+	if strictErr := xml.Unmarshal([]byte(doc), &resp); strictErr != nil {
+		resp = RAGResponse{Repaired: true}
+		if err := newLenientDecoder(repairRAGResponse(doc)).Decode(&resp); err != nil {
+			return nil, fmt.Errorf("parse rag_response: %w", strictErr)
+		}
+	}
+	if resp.SummaryText() == "" {
+		return nil, ErrIncompleteRAGResponse
+	}
+	return &resp, nil
+}
+
+// extractRAGResponse cuts the document out of text. A missing closing tag
+// keeps everything after the opening one for the repair pass.
+func extractRAGResponse(text string) (string, error) {
+	start := strings.Index(text, ragResponseOpen)
+	if start < 0 {
+		return "", ErrNoRAGResponse
+	}
+	doc := text[start:]
+	if end := strings.LastIndex(doc, ragResponseClose); end >= 0 {
+		return doc[:end+len(ragResponseClose)], nil
+	}
+	return strings.TrimSuffix(strings.TrimSpace(doc), "```"), nil
+}
+
+func newLenientDecoder(doc string) *xml.Decoder {
+	d := xml.NewDecoder(strings.NewReader(doc))
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	return d
+}
+
+// tagPattern matches start, end and self-closing tags. Comments,
+// processing instructions and CDATA do not match and are kept as text.
+var tagPattern = regexp.MustCompile(`<(/?)([A-Za-z_][\w.:-]*)[^<>]*?(/?)>`)
+
+// repairRAGResponse fixes the mistakes LLMs commonly make in the document.
+// Bare '&' is left to the lenient decoder.
+func repairRAGResponse(doc string) string {
+	doc = escapeStrayLess(doc)
+	// Drop a tag cut off by a truncated answer.
+	if lt := strings.LastIndexByte(doc, '<'); lt > strings.LastIndexByte(doc, '>') {
+		doc = doc[:lt]
+	}
+
+	// The lenient decoder pairs a mismatched end tag with the open element
+	// but then closes one element too many, so rewrite end tags to close
+	// exactly the elements that are open.
+	var (
+		buf  bytes.Buffer
+		open []string
+		last int
+	)
+	closeTo := func(depth int) {
+		for len(open) > depth {
+			fmt.Fprintf(&buf, "</%s>", open[len(open)-1])
+			open = open[:len(open)-1]
+		}
+	}
+	for _, m := range tagPattern.FindAllStringSubmatchIndex(doc, -1) {
+		buf.WriteString(doc[last:m[0]])
+		last = m[1]
+		name := doc[m[4]:m[5]]
+		switch {
+		case m[3] > m[2]:
+			// An end tag closes the innermost element of that name and
+			// anything left open inside it; an unknown name closes the
+			// innermost element.
+			if depth := lastIndex(open, name); depth >= 0 {
+				closeTo(depth)
+			} else if len(open) > 0 {
+				closeTo(len(open) - 1)
+			}
+		case m[7] > m[6]:
+			buf.WriteString(doc[m[0]:m[1]])
+		default:
+			buf.WriteString(doc[m[0]:m[1]])
+			open = append(open, name)
+		}
+	}
+	buf.WriteString(doc[last:])
+	closeTo(0)
+	return buf.String()
+}
+
+// escapeStrayLess escapes each '<' that cannot start a tag: one followed
+// by a character no tag starts with, as in "a < b", or by another '<'
+// before any '>', as in "a<b</text>". A '<' followed by neither is a tag
+// cut off at the end and is left for the caller to drop.
+func escapeStrayLess(doc string) string {
+	var buf strings.Builder
+	last := 0
+	for i := 0; i < len(doc); i++ {
+		if doc[i] != '<' {
+			continue
+		}
+		rest := doc[i+1:]
+		gt, lt := strings.IndexByte(rest, '>'), strings.IndexByte(rest, '<')
+		if gt < 0 && lt < 0 {
+			continue
+		}
+		if startsTag(rest) && gt >= 0 && (lt < 0 || gt < lt) {
+			continue
+		}
+		buf.WriteString(doc[last:i])
+		buf.WriteString("&lt;")
+		last = i + 1
+	}
+	buf.WriteString(doc[last:])
+	return buf.String()
+}
+
+// startsTag reports whether s, the text after a '<', can begin a tag,
+// comment or processing instruction.
+func startsTag(s string) bool {
+	if s == "" {
+		return false
+	}
+	c := s[0]
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '/' || c == '?' || c == '!' || c == '_'
+}
+
+func lastIndex(names []string, name string) int {
+	for i := len(names) - 1; i >= 0; i-- {
+		if names[i] == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,207 @@
+package prompts
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseRAGResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		summary   string
+		keyPoints []string
+		repaired  bool
+		err       error
+	}{
+		{
+			name:      "well formed in code fence",
+			text:      "```xml\n<rag_response><summary><text>答案</text></summary><key_points><point>一</point><point>二</point></key_points></rag_response>\n```\n以上。",
+			summary:   "答案",
+			keyPoints: []string{"一", "二"},
+		},
+		{
+			name:    "bare summary",
+			text:    "<rag_response><summary> 直接的总结 </summary></rag_response>",
+			summary: "直接的总结",
+		},
+		{
+			name:     "stray less-than",
+			text:     "<rag_response><summary><text>延迟 < 10ms 且 a<b</text></summary></rag_response>",
+			summary:  "延迟 < 10ms 且 a<b",
+			repaired: true,
+		},
+		{
+			name:     "bare ampersand",
+			text:     "<rag_response><summary><text>R&D 与 Q&A</text></summary></rag_response>",
+			summary:  "R&D 与 Q&A",
+			repaired: true,
+		},
+		{
+			name:      "truncated inside text",
+			text:      "<rag_response><summary><text>总结</text></summary><key_points><point>一</point><point>二的前半",
+			summary:   "总结",
+			keyPoints: []string{"一", "二的前半"},
+			repaired:  true,
+		},
+		{
+			name:      "truncated inside a tag",
+			text:      "<rag_response><summary><text>总结</text></summary><key_points><point>一</point></poi",
+			summary:   "总结",
+			keyPoints: []string{"一"},
+			repaired:  true,
+		},
+		{
+			name:      "mismatched end tag",
+			text:      "<rag_response><summary><text>总结</txt></summary><key_points><point>一</point></key_points></rag_response>",
+			summary:   "总结",
+			keyPoints: []string{"一"},
+			repaired:  true,
+		},
+		{
+			name: "no document",
+			text: "抱歉，我无法回答。",
+			err:  ErrNoRAGResponse,
+		},
+		{
+			name: "no summary",
+			text: "<rag_response><key_points><point>一</point></key_points></rag_response>",
+			err:  ErrIncompleteRAGResponse,
+		},
+		{
+			name: "truncated before the summary",
+			text: "<rag_response><summ",
+			err:  ErrIncompleteRAGResponse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := ParseRAGResponse(tt.text)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := resp.SummaryText(); got != tt.summary {
+				t.Errorf("summary = %q, want %q", got, tt.summary)
+			}
+			if len(resp.KeyPoints) > 0 || len(tt.keyPoints) > 0 {
+				if !reflect.DeepEqual(resp.KeyPoints, tt.keyPoints) {
+					t.Errorf("key points = %q, want %q", resp.KeyPoints, tt.keyPoints)
+				}
+			}
+			if resp.Repaired != tt.repaired {
+				t.Errorf("repaired = %v, want %v", resp.Repaired, tt.repaired)
+			}
+		})
+	}
+}
+
+func TestParseRAGResponseSections(t *testing.T) {
+	text := `<rag_response>
+  <summary><text>总结</text></summary>
+  <main_content><info_points><point><title>要点</title><content>内容</content></point></info_points></main_content>
+  <detailed_content><section><title>章节</title><content>细节</content></section></detailed_content>
+  <completeness><assessment>完整</assessment><missing_info>无</missing_info></completeness>
+  <sources>
+    <source><id>doc-1</id><similarity>0.89（高）</similarity><summary>来源</summary></source>
+    <source><id>doc-2</id><similarity>高</similarity></source>
+  </sources>
+</rag_response>`
+
+	resp, err := ParseRAGResponse(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []RAGPoint{{Title: "要点", Content: "内容"}}; !reflect.DeepEqual(resp.InfoPoints, want) {
+		t.Errorf("info points = %+v, want %+v", resp.InfoPoints, want)
+	}
+	if want := []RAGPoint{{Title: "章节", Content: "细节"}}; !reflect.DeepEqual(resp.Sections, want) {
+		t.Errorf("sections = %+v, want %+v", resp.Sections, want)
+	}
+	if want := (RAGCompleteness{Assessment: "完整", MissingInfo: "无"}); resp.Completeness != want {
+		t.Errorf("completeness = %+v, want %+v", resp.Completeness, want)
+	}
+	if len(resp.Sources) != 2 {
+		t.Fatalf("got %d sources, want 2", len(resp.Sources))
+	}
+	if s := resp.Sources[0]; s.ID != "doc-1" || s.Score() != 0.89 || s.Summary != "来源" {
+		t.Errorf("source 0 = %+v", s)
+	}
+	if s := resp.Sources[1]; s.Score() != 0 {
+		t.Errorf("score without a number = %v, want 0", s.Score())
+	}
+}
+
+func TestRepairRAGResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{
+			name: "well formed unchanged",
+			doc:  "<rag_response><summary>s</summary></rag_response>",
+			want: "<rag_response><summary>s</summary></rag_response>",
+		},
+		{
+			name: "stray less-than escaped",
+			doc:  "<a>1 < 2 <= 3</a>",
+			want: "<a>1 &lt; 2 &lt;= 3</a>",
+		},
+		{
+			name: "open elements closed in order",
+			doc:  "<a><b><c>text",
+			want: "<a><b><c>text</c></b></a>",
+		},
+		{
+			name: "partial tag dropped",
+			doc:  "<a><b>text</b><c",
+			want: "<a><b>text</b></a>",
+		},
+		{
+			name: "partial end tag dropped",
+			doc:  "<a><b>text</",
+			want: "<a><b>text</b></a>",
+		},
+		{
+			name: "less-than before a tag escaped",
+			doc:  "<a>x<y</a>",
+			want: "<a>x&lt;y</a>",
+		},
+		{
+			name: "mismatched end tag renamed",
+			doc:  "<a><b>text</c><d/></a>",
+			want: "<a><b>text</b><d/></a>",
+		},
+		{
+			name: "end tag closes inner elements",
+			doc:  "<a><b><c>text</b>tail</a>",
+			want: "<a><b><c>text</c></b>tail</a>",
+		},
+		{
+			name: "unmatched end tag at top level dropped",
+			doc:  "</x><a>text</a>",
+			want: "<a>text</a>",
+		},
+		{
+			name: "comments and declarations kept",
+			doc:  "<?xml version=\"1.0\"?><a><!-- note -->text</a>",
+			want: "<?xml version=\"1.0\"?><a><!-- note -->text</a>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := repairRAGResponse(tt.doc); got != tt.want {
+				t.Errorf("repairRAGResponse(%q) = %q, want %q", tt.doc, got, tt.want)
+			}
+		})
+	}
+}
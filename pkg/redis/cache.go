@@ -17,15 +17,18 @@ const (
 	DocumentCacheTTL     = 6 * time.Hour
 	SearchResultCacheTTL = 30 * time.Minute
 	Doc2XCacheTTL        = 7 * 24 * time.Hour
+	SessionTTL           = 24 * time.Hour
 )
 
-func (s *CacheService) CacheEmbedding(ctx context.Context, text string, embedding []float32) error {
-	key := fmt.Sprintf("embedding:%s", hashText(text))
+// CacheEmbedding caches text's embedding under model, so collections
+// using different models never share vectors.
+func (s *CacheService) CacheEmbedding(ctx context.Context, model, text string, embedding []float32) error {
+	key := fmt.Sprintf("embedding:%s", hashText(model+"\x00"+text))
 	return s.client.SetJSON(ctx, key, embedding, EmbeddingCacheTTL)
 }
 
-func (s *CacheService) GetEmbedding(ctx context.Context, text string) ([]float32, error) {
-	key := fmt.Sprintf("embedding:%s", hashText(text))
+func (s *CacheService) GetEmbedding(ctx context.Context, model, text string) ([]float32, error) {
+	key := fmt.Sprintf("embedding:%s", hashText(model+"\x00"+text))
 	var embedding []float32
 	if err := s.client.GetJSON(ctx, key, &embedding); err != nil {
 		return nil, err
@@ -54,7 +54,7 @@ func RerankChunksWithKeywords(chunks []adapters.ChunkSearchResult, query string,
 	}
 	var filtered []adapters.ChunkSearchResult
 	for _, c := range chunks {
-		if c.Similarity > minSimilarity {
+		if similarity, ok := vectorSimilarity(c); !ok || similarity > minSimilarity {
 			filtered = append(filtered, c)
 		}
 	}
@@ -66,6 +66,17 @@ func RerankChunksWithKeywords(chunks []adapters.ChunkSearchResult, query string,
 	return filtered
 }
 
+// vectorSimilarity returns the cosine similarity of the chunk to the query.
+// Fused chunks keep it in Metadata["vector_similarity"], as their Similarity
+// is the fused score; ok is false for chunks found by keyword search only.
+func vectorSimilarity(c adapters.ChunkSearchResult) (float32, bool) {
+	if _, fused := c.Metadata["fusion"]; !fused {
+		return c.Similarity, true
+	}
+	similarity, ok := c.Metadata["vector_similarity"].(float64)
+	return float32(similarity), ok
+}
+
 func sortByAdvancedScore(chunks []adapters.ChunkSearchResult) {
 	n := len(chunks)
 	for i := 0; i < n-1; i++ {
@@ -4,10 +4,11 @@ import * as React from "react"
 import { createConnectTransport } from "@connectrpc/connect-web"
 import { createPromiseClient } from "@connectrpc/connect"
 import { UploadZone } from "@/components/rag/upload-zone"
-import { PipelineVisualizer, type PipelineStep } from "@/components/rag/pipeline-visualizer"
+import { PipelineVisualizer, pipelineStepAfter, type PipelineStep } from "@/components/rag/pipeline-visualizer"
 import { QueryInput } from "@/components/rag/query-input"
 import { FileText, Database, BrainCircuit, Sparkles } from "lucide-react"
 import { RagService } from "@/gen/rag/v1/rag_connect"
+import { IngestionJobStatus, type RagAnswer } from "@/gen/rag/v1/rag_pb"
 import { RagResponseDisplay } from "@/components/rag/rag-response-display"
 import { KeywordsVisualizer } from "@/components/rag/keywords-visualizer"
 import { SettingsDialog } from "@/components/rag/settings-dialog"
@@ -58,6 +59,7 @@ export default function Home() {
     const [isUploading, setIsUploading] = React.useState(false)
     const [uploadSuccess, setUploadSuccess] = React.useState(false)
     const [queryResult, setQueryResult] = React.useState<string | null>(null)
+    const [queryAnswer, setQueryAnswer] = React.useState<RagAnswer | undefined>(undefined)
     const [resultKeywords, setResultKeywords] = React.useState<string[]>([])
     const [documentRefresh, setDocumentRefresh] = React.useState(0)
     const [optimisticDoc, setOptimisticDoc] = React.useState<{
@@ -68,6 +70,23 @@ export default function Home() {
     } | null>(null)
     const [latency, setLatency] = React.useState<number | null>(null)
 
+    const waitForIngestionJob = async (jobId: string, onProgress: (p: number) => void): Promise<string> => {
+        for (;;) {
+            const { job } = await client.getIngestionJob({ jobId })
+            if (!job) {
+                throw new Error(`Ingestion job ${jobId} not found`)
+            }
+            onProgress(job.progress)
+            if (job.status === IngestionJobStatus.SUCCEEDED) {
+                return job.documentId
+            }
+            if (job.status === IngestionJobStatus.FAILED) {
+                throw new Error(job.error || "Ingestion failed")
+            }
+            await new Promise((resolve) => setTimeout(resolve, 1500))
+        }
+    }
+
     const handleUpload = async (file: File) => {
         setIsUploading(true)
         setUploadSuccess(false)
@@ -84,18 +103,33 @@ export default function Home() {
 
             // 2) Upload the file to object storage (PUT to the presigned URL)
             await uploadToPresignedUrl(preUpload.uploadUrl, file, (p) => {
-                // Scale progress to 15 -> 90 during upload
-                setUploadProgress(15 + Math.round((p / 100) * 75))
+                // Scale progress to 15 -> 50 during upload
+                setUploadProgress(15 + Math.round((p / 100) * 35))
             })
 
-            // 3) Notify backend to process the uploaded file
-            const uploadResp = await client.uploadPdf({ fileKey: preUpload.fileKey, filename: file.name })
+            // 3) Enqueue the ingestion job, then poll it until it finishes
+            const uploadResp = await client.uploadDocument({
+                fileKey: preUpload.fileKey,
+                filename: file.name,
+                contentType: file.type,
+            })
+            if (!uploadResp.success) {
+                throw new Error(uploadResp.message)
+            }
+            // Duplicates may resolve to an existing document without a job
+            let documentId = uploadResp.documentId
+            if (uploadResp.jobId) {
+                documentId = await waitForIngestionJob(uploadResp.jobId, (p) => {
+                    // Scale progress to 50 -> 100 during ingestion
+                    setUploadProgress(50 + Math.round((p / 100) * 50))
+                })
+            }
 
             setUploadProgress(100)
             setUploadSuccess(true)
             // show immediately in list
             setOptimisticDoc({
-                id: uploadResp.documentId || preUpload.fileKey,
+                id: documentId || preUpload.fileKey,
                 title: file.name,
                 createdAt: new Date().toISOString(),
                 minioKey: preUpload.fileKey,
@@ -114,23 +148,35 @@ export default function Home() {
     const handleSearch = async (query: string) => {
         setPipelineStep("keywords") // Start animation loop
         setQueryResult(null)
+        setQueryAnswer(undefined)
         setResultKeywords([])
         setLatency(null)
 
         try {
-            // Start actual search
+            // Stream the pipeline so each stage lights up as soon as the server finishes it
             const startTime = performance.now()
-            // Call the unified RAG endpoint with 1 minute timeout
-            const res = await client.getContext({ query }, {
+            let summary = ""
+            for await (const res of client.streamContext({ query }, {
                 timeoutMs: 60000 // 1 minute
-            })
-            const endTime = performance.now()
-            setLatency(endTime - startTime)
+            })) {
+                setPipelineStep(pipelineStepAfter(res.stage))
 
-            // Once we have result, show summary step
-            setPipelineStep("summary")
-            setQueryResult(res.context)
-            setResultKeywords(res.keywords)
+                switch (res.event.case) {
+                    case "keywordsReady":
+                        setResultKeywords(res.event.value.keywords)
+                        break
+                    case "summaryDelta":
+                        summary += res.event.value.content
+                        setQueryResult(summary)
+                        break
+                    case "done":
+                        setLatency(performance.now() - startTime)
+                        setQueryResult(res.event.value.context)
+                        setQueryAnswer(res.event.value.ragAnswer)
+                        setResultKeywords(res.event.value.keywords)
+                        break
+                }
+            }
 
         } catch (error) {
             console.error("Pipeline failed, showing demo result", error)
@@ -144,6 +190,7 @@ export default function Home() {
             setPipelineStep(step)
             await new Promise(r => setTimeout(r, 1500))
         }
+        setPipelineStep("complete")
         setQueryResult(`
 <rag_response>
     <summary>Based on the retrieval, verify the provided documents...</summary>
@@ -213,7 +260,7 @@ export default function Home() {
                                 <Sparkles className="w-5 h-5 text-indigo-500" />
                                 知识检索
                             </h2>
-                            <QueryInput onSearch={handleSearch} isLoading={pipelineStep !== "idle" && pipelineStep !== "summary"} />
+                            <QueryInput onSearch={handleSearch} isLoading={pipelineStep !== "idle" && pipelineStep !== "complete"} />
                         </div>
 
                         {/* Pipeline Visualization */}
@@ -255,7 +302,7 @@ export default function Home() {
                                         </div>
                                     </div>
                                 </div>
-                                <RagResponseDisplay content={queryResult} />
+                                <RagResponseDisplay content={queryResult} answer={queryAnswer} />
                             </div>
                         )}
                     </div>
@@ -1,9 +1,29 @@
 import * as React from "react"
 import * as d3 from "d3"
 import { cn } from "@/lib/utils"
+import { ContextStage } from "@/gen/rag/v1/rag_pb"
 
 export type PipelineStep = "idle" | "keywords" | "embedding" | "search" | "rerank" | "summary" | "complete"
 
+// Maps the stage the server just reported as finished to the step that is now in progress.
+export function pipelineStepAfter(stage: ContextStage): PipelineStep {
+    switch (stage) {
+        case ContextStage.KEYWORDS:
+            return "embedding"
+        case ContextStage.EMBEDDING:
+            return "search"
+        case ContextStage.SEARCH:
+            return "rerank"
+        case ContextStage.RERANK:
+        case ContextStage.SUMMARY:
+            return "summary"
+        case ContextStage.COMPLETE:
+            return "complete"
+        default:
+            return "keywords"
+    }
+}
+
 interface PipelineVisualizerProps {
     currentStep: PipelineStep
     className?: string
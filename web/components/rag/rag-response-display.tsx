@@ -12,9 +12,12 @@ import {
     Check
 } from "lucide-react"
 import { cn } from "@/lib/utils"
+import type { RagAnswer } from "@/gen/rag/v1/rag_pb"
 
 interface RagResponseDisplayProps {
     content: string
+    // Structured answer parsed by the server; the XML in content is only parsed here when it is missing
+    answer?: RagAnswer
 }
 
 interface ParsedResponse {
@@ -34,7 +37,32 @@ interface ParsedResponse {
     footer?: string
 }
 
-export function RagResponseDisplay({ content }: RagResponseDisplayProps) {
+const RAG_RESPONSE_END = "</rag_response>"
+
+// Text after the XML document, such as the server's usage note
+const footerOf = (content: string) => {
+    const endIdx = content.lastIndexOf(RAG_RESPONSE_END)
+    return endIdx === -1 ? "" : content.substring(endIdx + RAG_RESPONSE_END.length).trim()
+}
+
+const fromAnswer = (answer: RagAnswer, content: string): ParsedResponse => ({
+    summary: answer.summary,
+    mainContent: answer.infoPoints.map(p => ({ title: p.title, content: p.content })),
+    detailedContent: answer.sections.map(s => ({ title: s.title, content: s.content })),
+    keyPoints: answer.keyPoints,
+    completeness: {
+        assessment: answer.completeness?.assessment ?? "",
+        missingInfo: answer.completeness?.missingInfo ?? ""
+    },
+    sources: answer.sources.map(s => ({
+        id: s.id,
+        similarity: String(s.similarity),
+        summary: s.summary
+    })),
+    footer: footerOf(content)
+})
+
+export function RagResponseDisplay({ content, answer }: RagResponseDisplayProps) {
     const [parsed, setParsed] = React.useState<ParsedResponse | null>(null)
     const [isRaw, setIsRaw] = React.useState(false)
     const [isCopied, setIsCopied] = React.useState(false)
@@ -46,6 +74,11 @@ export function RagResponseDisplay({ content }: RagResponseDisplayProps) {
     }
 
     React.useEffect(() => {
+        if (answer) {
+            setParsed(fromAnswer(answer, content))
+            setIsRaw(false)
+            return
+        }
         try {
             // Basic XML parsing
             const parser = new DOMParser()
@@ -112,7 +145,7 @@ export function RagResponseDisplay({ content }: RagResponseDisplayProps) {
             console.log("Failed to parse RAG response XML, falling back to raw text", e)
             setIsRaw(true)
         }
-    }, [content])
+    }, [content, answer])
 
     if (isRaw || !parsed) {
         return (
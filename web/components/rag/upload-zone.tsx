@@ -3,6 +3,9 @@ import { UploadCloud, FileText, CheckCircle, Loader2 } from "lucide-react"
 import { cn } from "@/lib/utils"
 import { Progress } from "@/components/ui/progress"
 
+// Keep in sync with the server's parser registry
+const SUPPORTED_EXTENSIONS = [".pdf", ".md", ".markdown", ".txt", ".html", ".htm", ".docx"]
+
 interface UploadZoneProps {
     onUpload: (file: File) => void
     isUploading: boolean
@@ -42,13 +45,13 @@ export function UploadZone({ onUpload, isUploading, progress, success }: UploadZ
     }
 
     const validateAndUpload = (file: File) => {
-        const isPdfType = file.type === "application/pdf"
-        const isPdfExt = file.name.toLowerCase().endsWith(".pdf")
+        const name = file.name.toLowerCase()
+        const isSupportedExt = SUPPORTED_EXTENSIONS.some((ext) => name.endsWith(ext))
 
-        if (isPdfType || isPdfExt) {
+        if (isSupportedExt) {
             onUpload(file)
         } else {
-            alert("请上传 PDF 文件")
+            alert("请上传 PDF、Markdown、文本、HTML 或 DOCX 文件")
         }
     }
 
@@ -69,7 +72,7 @@ export function UploadZone({ onUpload, isUploading, progress, success }: UploadZ
                 type="file"
                 ref={fileInputRef}
                 className="hidden"
-                accept=".pdf"
+                accept={SUPPORTED_EXTENSIONS.join(",")}
                 onChange={handleFileSelect}
                 disabled={isUploading || success}
             />
@@ -96,10 +99,10 @@ export function UploadZone({ onUpload, isUploading, progress, success }: UploadZ
                     </div>
                     <div className="space-y-1">
                         <h3 className="text-xl font-semibold tracking-tight">
-                            将 PDF 文件拖拽到此处
+                            将文档拖拽到此处
                         </h3>
                         <p className="text-sm text-muted-foreground">
-                            或点击上传
+                            或点击上传（PDF、Markdown、TXT、HTML、DOCX）
                         </p>
                     </div>
                 </div>
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { DeleteDocumentRequest, DeleteDocumentResponse, GetContextRequest, GetContextResponse, ListDocumentsRequest, ListDocumentsResponse, PreUploadRequest, PreUploadResponse, UploadPdfRequest, UploadPdfResponse } from "./rag_pb";
+import { ChatRequest, ChatResponse, CreateCollectionRequest, CreateCollectionResponse, CutoverReindexRequest, CutoverReindexResponse, DeleteCollectionRequest, DeleteCollectionResponse, DeleteDocumentRequest, DeleteDocumentResponse, DeleteTableSetRequest, DeleteTableSetResponse, GetContextRequest, GetContextResponse, GetIngestionJobRequest, GetIngestionJobResponse, GetReindexJobRequest, GetReindexJobResponse, ListCollectionsRequest, ListCollectionsResponse, ListDocumentsRequest, ListDocumentsResponse, ListIngestionJobsRequest, ListIngestionJobsResponse, ListTableSetsRequest, ListTableSetsResponse, PreUploadRequest, PreUploadResponse, RollbackReindexRequest, RollbackReindexResponse, SearchRequest, SearchResponse, StartReindexRequest, StartReindexResponse, StreamContextRequest, StreamContextResponse, UploadDocumentRequest, UploadDocumentResponse, UploadPdfRequest, UploadPdfResponse } from "./rag_pb";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -26,7 +26,7 @@ export const RagService = {
       kind: MethodKind.Unary,
     },
     /**
-     * 上传PDF文件并进行处理
+     * 提交PDF摄取任务，立即返回任务ID，由后台worker异步处理
      *
      * @generated from rpc rag.v1.RagService.UploadPdf
      */
@@ -36,6 +36,39 @@ export const RagService = {
       O: UploadPdfResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * 提交任意支持格式（PDF、Markdown、文本、HTML、DOCX）的摄取任务
+     *
+     * @generated from rpc rag.v1.RagService.UploadDocument
+     */
+    uploadDocument: {
+      name: "UploadDocument",
+      I: UploadDocumentRequest,
+      O: UploadDocumentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 查询摄取任务状态
+     *
+     * @generated from rpc rag.v1.RagService.GetIngestionJob
+     */
+    getIngestionJob: {
+      name: "GetIngestionJob",
+      I: GetIngestionJobRequest,
+      O: GetIngestionJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 列出摄取任务
+     *
+     * @generated from rpc rag.v1.RagService.ListIngestionJobs
+     */
+    listIngestionJobs: {
+      name: "ListIngestionJobs",
+      I: ListIngestionJobsRequest,
+      O: ListIngestionJobsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * 根据查询获取相关上下文
      *
@@ -47,6 +80,39 @@ export const RagService = {
       O: GetContextResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * 流式获取上下文，逐阶段推送检索进度并逐字输出总结
+     *
+     * @generated from rpc rag.v1.RagService.StreamContext
+     */
+    streamContext: {
+      name: "StreamContext",
+      I: StreamContextRequest,
+      O: StreamContextResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * 纯检索接口，仅返回排序后的分块，不调用大模型生成总结
+     *
+     * @generated from rpc rag.v1.RagService.Search
+     */
+    search: {
+      name: "Search",
+      I: SearchRequest,
+      O: SearchResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 多轮对话，服务端按会话保存历史并改写追问
+     *
+     * @generated from rpc rag.v1.RagService.Chat
+     */
+    chat: {
+      name: "Chat",
+      I: ChatRequest,
+      O: ChatResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * 列出已上传文档
      *
@@ -69,6 +135,105 @@ export const RagService = {
       O: DeleteDocumentResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * 创建集合（知识库）
+     *
+     * @generated from rpc rag.v1.RagService.CreateCollection
+     */
+    createCollection: {
+      name: "CreateCollection",
+      I: CreateCollectionRequest,
+      O: CreateCollectionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 列出集合
+     *
+     * @generated from rpc rag.v1.RagService.ListCollections
+     */
+    listCollections: {
+      name: "ListCollections",
+      I: ListCollectionsRequest,
+      O: ListCollectionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 删除集合（同时删除其全部文档和分块）
+     *
+     * @generated from rpc rag.v1.RagService.DeleteCollection
+     */
+    deleteCollection: {
+      name: "DeleteCollection",
+      I: DeleteCollectionRequest,
+      O: DeleteCollectionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 启动重建索引任务，用新的向量模型把全部文档写入新表集
+     *
+     * @generated from rpc rag.v1.RagService.StartReindex
+     */
+    startReindex: {
+      name: "StartReindex",
+      I: StartReindexRequest,
+      O: StartReindexResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 查询重建索引任务状态
+     *
+     * @generated from rpc rag.v1.RagService.GetReindexJob
+     */
+    getReindexJob: {
+      name: "GetReindexJob",
+      I: GetReindexJobRequest,
+      O: GetReindexJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 把已就绪的重建任务原子切换为生效表集
+     *
+     * @generated from rpc rag.v1.RagService.CutoverReindex
+     */
+    cutoverReindex: {
+      name: "CutoverReindex",
+      I: CutoverReindexRequest,
+      O: CutoverReindexResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 回滚已切换的重建任务，重新启用原表集
+     *
+     * @generated from rpc rag.v1.RagService.RollbackReindex
+     */
+    rollbackReindex: {
+      name: "RollbackReindex",
+      I: RollbackReindexRequest,
+      O: RollbackReindexResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 列出全部表集
+     *
+     * @generated from rpc rag.v1.RagService.ListTableSets
+     */
+    listTableSets: {
+      name: "ListTableSets",
+      I: ListTableSetsRequest,
+      O: ListTableSetsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 删除未生效的表集
+     *
+     * @generated from rpc rag.v1.RagService.DeleteTableSet
+     */
+    deleteTableSet: {
+      name: "DeleteTableSet",
+      I: DeleteTableSetRequest,
+      O: DeleteTableSetResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
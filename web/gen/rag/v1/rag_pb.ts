@@ -6,6 +6,496 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 
+/**
+ * DedupePolicy 上传内容与已有文档重复时的处理策略
+ *
+ * @generated from enum rag.v1.DedupePolicy
+ */
+export enum DedupePolicy {
+  /**
+   * 未指定，使用服务端配置 ingestion.dedupe_policy
+   *
+   * @generated from enum value: DEDUPE_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 拒绝上传
+   *
+   * @generated from enum value: DEDUPE_POLICY_REJECT = 1;
+   */
+  REJECT = 1,
+
+  /**
+   * 直接返回已有文档
+   *
+   * @generated from enum value: DEDUPE_POLICY_RETURN_EXISTING = 2;
+   */
+  RETURN_EXISTING = 2,
+
+  /**
+   * 重新处理并替换已有文档
+   *
+   * @generated from enum value: DEDUPE_POLICY_REPLACE = 3;
+   */
+  REPLACE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DedupePolicy)
+proto3.util.setEnumType(DedupePolicy, "rag.v1.DedupePolicy", [
+  { no: 0, name: "DEDUPE_POLICY_UNSPECIFIED" },
+  { no: 1, name: "DEDUPE_POLICY_REJECT" },
+  { no: 2, name: "DEDUPE_POLICY_RETURN_EXISTING" },
+  { no: 3, name: "DEDUPE_POLICY_REPLACE" },
+]);
+
+/**
+ * DedupeResult 上传请求实际采取的处理路径
+ *
+ * @generated from enum rag.v1.DedupeResult
+ */
+export enum DedupeResult {
+  /**
+   * 未指定
+   *
+   * @generated from enum value: DEDUPE_RESULT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 新内容，已提交摄取任务
+   *
+   * @generated from enum value: DEDUPE_RESULT_CREATED = 1;
+   */
+  CREATED = 1,
+
+  /**
+   * 内容重复，返回已有文档，未提交任务
+   *
+   * @generated from enum value: DEDUPE_RESULT_EXISTING = 2;
+   */
+  EXISTING = 2,
+
+  /**
+   * 内容重复，已拒绝
+   *
+   * @generated from enum value: DEDUPE_RESULT_REJECTED = 3;
+   */
+  REJECTED = 3,
+
+  /**
+   * 内容重复，已提交替换已有文档的摄取任务
+   *
+   * @generated from enum value: DEDUPE_RESULT_REPLACED = 4;
+   */
+  REPLACED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DedupeResult)
+proto3.util.setEnumType(DedupeResult, "rag.v1.DedupeResult", [
+  { no: 0, name: "DEDUPE_RESULT_UNSPECIFIED" },
+  { no: 1, name: "DEDUPE_RESULT_CREATED" },
+  { no: 2, name: "DEDUPE_RESULT_EXISTING" },
+  { no: 3, name: "DEDUPE_RESULT_REJECTED" },
+  { no: 4, name: "DEDUPE_RESULT_REPLACED" },
+]);
+
+/**
+ * IngestionJobStatus 摄取任务状态
+ *
+ * @generated from enum rag.v1.IngestionJobStatus
+ */
+export enum IngestionJobStatus {
+  /**
+   * 未指定
+   *
+   * @generated from enum value: INGESTION_JOB_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 排队中
+   *
+   * @generated from enum value: INGESTION_JOB_STATUS_QUEUED = 1;
+   */
+  QUEUED = 1,
+
+  /**
+   * 处理中
+   *
+   * @generated from enum value: INGESTION_JOB_STATUS_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * 已成功
+   *
+   * @generated from enum value: INGESTION_JOB_STATUS_SUCCEEDED = 3;
+   */
+  SUCCEEDED = 3,
+
+  /**
+   * 已失败
+   *
+   * @generated from enum value: INGESTION_JOB_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(IngestionJobStatus)
+proto3.util.setEnumType(IngestionJobStatus, "rag.v1.IngestionJobStatus", [
+  { no: 0, name: "INGESTION_JOB_STATUS_UNSPECIFIED" },
+  { no: 1, name: "INGESTION_JOB_STATUS_QUEUED" },
+  { no: 2, name: "INGESTION_JOB_STATUS_RUNNING" },
+  { no: 3, name: "INGESTION_JOB_STATUS_SUCCEEDED" },
+  { no: 4, name: "INGESTION_JOB_STATUS_FAILED" },
+]);
+
+/**
+ * IngestionStage 摄取任务处理阶段
+ *
+ * @generated from enum rag.v1.IngestionStage
+ */
+export enum IngestionStage {
+  /**
+   * 未指定
+   *
+   * @generated from enum value: INGESTION_STAGE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 等待 worker 领取
+   *
+   * @generated from enum value: INGESTION_STAGE_QUEUED = 1;
+   */
+  QUEUED = 1,
+
+  /**
+   * 从对象存储下载文件
+   *
+   * @generated from enum value: INGESTION_STAGE_DOWNLOADING = 2;
+   */
+  DOWNLOADING = 2,
+
+  /**
+   * 解析文档内容
+   *
+   * @generated from enum value: INGESTION_STAGE_PARSING = 3;
+   */
+  PARSING = 3,
+
+  /**
+   * 文本分块
+   *
+   * @generated from enum value: INGESTION_STAGE_CHUNKING = 4;
+   */
+  CHUNKING = 4,
+
+  /**
+   * 生成向量并入库
+   *
+   * @generated from enum value: INGESTION_STAGE_EMBEDDING = 5;
+   */
+  EMBEDDING = 5,
+
+  /**
+   * 处理完成
+   *
+   * @generated from enum value: INGESTION_STAGE_COMPLETED = 6;
+   */
+  COMPLETED = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(IngestionStage)
+proto3.util.setEnumType(IngestionStage, "rag.v1.IngestionStage", [
+  { no: 0, name: "INGESTION_STAGE_UNSPECIFIED" },
+  { no: 1, name: "INGESTION_STAGE_QUEUED" },
+  { no: 2, name: "INGESTION_STAGE_DOWNLOADING" },
+  { no: 3, name: "INGESTION_STAGE_PARSING" },
+  { no: 4, name: "INGESTION_STAGE_CHUNKING" },
+  { no: 5, name: "INGESTION_STAGE_EMBEDDING" },
+  { no: 6, name: "INGESTION_STAGE_COMPLETED" },
+]);
+
+/**
+ * MetadataScope 元数据条件作用的表
+ *
+ * @generated from enum rag.v1.MetadataScope
+ */
+export enum MetadataScope {
+  /**
+   * 未指定，按文档处理
+   *
+   * @generated from enum value: METADATA_SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 文档 metadata
+   *
+   * @generated from enum value: METADATA_SCOPE_DOCUMENT = 1;
+   */
+  DOCUMENT = 1,
+
+  /**
+   * 分块 metadata
+   *
+   * @generated from enum value: METADATA_SCOPE_CHUNK = 2;
+   */
+  CHUNK = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MetadataScope)
+proto3.util.setEnumType(MetadataScope, "rag.v1.MetadataScope", [
+  { no: 0, name: "METADATA_SCOPE_UNSPECIFIED" },
+  { no: 1, name: "METADATA_SCOPE_DOCUMENT" },
+  { no: 2, name: "METADATA_SCOPE_CHUNK" },
+]);
+
+/**
+ * MetadataOperator 元数据比较运算符，比较按 jsonb 规则进行，类型不同的值不匹配
+ *
+ * @generated from enum rag.v1.MetadataOperator
+ */
+export enum MetadataOperator {
+  /**
+   * 未指定
+   *
+   * @generated from enum value: METADATA_OPERATOR_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 等于
+   *
+   * @generated from enum value: METADATA_OPERATOR_EQ = 1;
+   */
+  EQ = 1,
+
+  /**
+   * 不等于（键不存在时不匹配）
+   *
+   * @generated from enum value: METADATA_OPERATOR_NE = 2;
+   */
+  NE = 2,
+
+  /**
+   * 等于任一值
+   *
+   * @generated from enum value: METADATA_OPERATOR_IN = 3;
+   */
+  IN = 3,
+
+  /**
+   * JSON 包含，如数组包含某元素
+   *
+   * @generated from enum value: METADATA_OPERATOR_CONTAINS = 4;
+   */
+  CONTAINS = 4,
+
+  /**
+   * 键存在
+   *
+   * @generated from enum value: METADATA_OPERATOR_EXISTS = 5;
+   */
+  EXISTS = 5,
+
+  /**
+   * 大于
+   *
+   * @generated from enum value: METADATA_OPERATOR_GT = 6;
+   */
+  GT = 6,
+
+  /**
+   * 大于等于
+   *
+   * @generated from enum value: METADATA_OPERATOR_GTE = 7;
+   */
+  GTE = 7,
+
+  /**
+   * 小于
+   *
+   * @generated from enum value: METADATA_OPERATOR_LT = 8;
+   */
+  LT = 8,
+
+  /**
+   * 小于等于
+   *
+   * @generated from enum value: METADATA_OPERATOR_LTE = 9;
+   */
+  LTE = 9,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MetadataOperator)
+proto3.util.setEnumType(MetadataOperator, "rag.v1.MetadataOperator", [
+  { no: 0, name: "METADATA_OPERATOR_UNSPECIFIED" },
+  { no: 1, name: "METADATA_OPERATOR_EQ" },
+  { no: 2, name: "METADATA_OPERATOR_NE" },
+  { no: 3, name: "METADATA_OPERATOR_IN" },
+  { no: 4, name: "METADATA_OPERATOR_CONTAINS" },
+  { no: 5, name: "METADATA_OPERATOR_EXISTS" },
+  { no: 6, name: "METADATA_OPERATOR_GT" },
+  { no: 7, name: "METADATA_OPERATOR_GTE" },
+  { no: 8, name: "METADATA_OPERATOR_LT" },
+  { no: 9, name: "METADATA_OPERATOR_LTE" },
+]);
+
+/**
+ * ContextStage 上下文检索流程阶段
+ *
+ * @generated from enum rag.v1.ContextStage
+ */
+export enum ContextStage {
+  /**
+   * 未指定
+   *
+   * @generated from enum value: CONTEXT_STAGE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 关键词提取
+   *
+   * @generated from enum value: CONTEXT_STAGE_KEYWORDS = 1;
+   */
+  KEYWORDS = 1,
+
+  /**
+   * 查询向量生成
+   *
+   * @generated from enum value: CONTEXT_STAGE_EMBEDDING = 2;
+   */
+  EMBEDDING = 2,
+
+  /**
+   * 向量检索
+   *
+   * @generated from enum value: CONTEXT_STAGE_SEARCH = 3;
+   */
+  SEARCH = 3,
+
+  /**
+   * 重排序
+   *
+   * @generated from enum value: CONTEXT_STAGE_RERANK = 4;
+   */
+  RERANK = 4,
+
+  /**
+   * 大模型总结
+   *
+   * @generated from enum value: CONTEXT_STAGE_SUMMARY = 5;
+   */
+  SUMMARY = 5,
+
+  /**
+   * 全部完成
+   *
+   * @generated from enum value: CONTEXT_STAGE_COMPLETE = 6;
+   */
+  COMPLETE = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ContextStage)
+proto3.util.setEnumType(ContextStage, "rag.v1.ContextStage", [
+  { no: 0, name: "CONTEXT_STAGE_UNSPECIFIED" },
+  { no: 1, name: "CONTEXT_STAGE_KEYWORDS" },
+  { no: 2, name: "CONTEXT_STAGE_EMBEDDING" },
+  { no: 3, name: "CONTEXT_STAGE_SEARCH" },
+  { no: 4, name: "CONTEXT_STAGE_RERANK" },
+  { no: 5, name: "CONTEXT_STAGE_SUMMARY" },
+  { no: 6, name: "CONTEXT_STAGE_COMPLETE" },
+]);
+
+/**
+ * ReindexMode 重建索引方式
+ *
+ * @generated from enum rag.v1.ReindexMode
+ */
+export enum ReindexMode {
+  /**
+   * 未指定，按 REEMBED 处理
+   *
+   * @generated from enum value: REINDEX_MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 沿用现有分块，只重新生成向量
+   *
+   * @generated from enum value: REINDEX_MODE_REEMBED = 1;
+   */
+  REEMBED = 1,
+
+  /**
+   * 从已处理文本或原文件重新分块后生成向量
+   *
+   * @generated from enum value: REINDEX_MODE_RECHUNK = 2;
+   */
+  RECHUNK = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ReindexMode)
+proto3.util.setEnumType(ReindexMode, "rag.v1.ReindexMode", [
+  { no: 0, name: "REINDEX_MODE_UNSPECIFIED" },
+  { no: 1, name: "REINDEX_MODE_REEMBED" },
+  { no: 2, name: "REINDEX_MODE_RECHUNK" },
+]);
+
+/**
+ * ReindexJobStatus 重建索引任务状态
+ *
+ * @generated from enum rag.v1.ReindexJobStatus
+ */
+export enum ReindexJobStatus {
+  /**
+   * 未指定
+   *
+   * @generated from enum value: REINDEX_JOB_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 正在写入新表集
+   *
+   * @generated from enum value: REINDEX_JOB_STATUS_RUNNING = 1;
+   */
+  RUNNING = 1,
+
+  /**
+   * 新表集已就绪，等待切换
+   *
+   * @generated from enum value: REINDEX_JOB_STATUS_READY = 2;
+   */
+  READY = 2,
+
+  /**
+   * 已切换到新表集
+   *
+   * @generated from enum value: REINDEX_JOB_STATUS_ACTIVE = 3;
+   */
+  ACTIVE = 3,
+
+  /**
+   * 已回滚到原表集
+   *
+   * @generated from enum value: REINDEX_JOB_STATUS_ROLLED_BACK = 4;
+   */
+  ROLLED_BACK = 4,
+
+  /**
+   * 已失败
+   *
+   * @generated from enum value: REINDEX_JOB_STATUS_FAILED = 5;
+   */
+  FAILED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ReindexJobStatus)
+proto3.util.setEnumType(ReindexJobStatus, "rag.v1.ReindexJobStatus", [
+  { no: 0, name: "REINDEX_JOB_STATUS_UNSPECIFIED" },
+  { no: 1, name: "REINDEX_JOB_STATUS_RUNNING" },
+  { no: 2, name: "REINDEX_JOB_STATUS_READY" },
+  { no: 3, name: "REINDEX_JOB_STATUS_ACTIVE" },
+  { no: 4, name: "REINDEX_JOB_STATUS_ROLLED_BACK" },
+  { no: 5, name: "REINDEX_JOB_STATUS_FAILED" },
+]);
+
 /**
  * 预上传请求
  *
@@ -13,7 +503,7 @@ import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
  */
 export class PreUploadRequest extends Message<PreUploadRequest> {
   /**
-   * 文件名，必须是PDF格式
+   * 文件名，扩展名必须是已注册解析器支持的格式
    *
    * @generated from field: string filename = 1;
    */
@@ -72,316 +562,3419 @@ export class PreUploadResponse extends Message<PreUploadResponse> {
    *
    * @generated from field: int64 expires_in = 3;
    */
-  expiresIn = protoInt64.zero;
+  expiresIn = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PreUploadResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.PreUploadResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "upload_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "file_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "expires_in", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreUploadResponse {
+    return new PreUploadResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreUploadResponse {
+    return new PreUploadResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreUploadResponse {
+    return new PreUploadResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreUploadResponse | PlainMessage<PreUploadResponse> | undefined, b: PreUploadResponse | PlainMessage<PreUploadResponse> | undefined): boolean {
+    return proto3.util.equals(PreUploadResponse, a, b);
+  }
+}
+
+/**
+ * 上传PDF请求
+ *
+ * @generated from message rag.v1.UploadPdfRequest
+ */
+export class UploadPdfRequest extends Message<UploadPdfRequest> {
+  /**
+   * 文件键不能为空
+   *
+   * @generated from field: string file_key = 1;
+   */
+  fileKey = "";
+
+  /**
+   * 文件名不能为空且必须是PDF文件
+   *
+   * @generated from field: string filename = 2;
+   */
+  filename = "";
+
+  /**
+   * 重复内容处理策略，未指定时使用服务端配置
+   *
+   * @generated from field: rag.v1.DedupePolicy dedupe_policy = 3;
+   */
+  dedupePolicy = DedupePolicy.UNSPECIFIED;
+
+  /**
+   * 所属集合 ID，为空表示不属于任何集合
+   *
+   * @generated from field: string collection_id = 4;
+   */
+  collectionId = "";
+
+  /**
+   * 文档标签，写入文档 metadata.tags，可用 SearchFilter.tags 过滤
+   *
+   * @generated from field: repeated string tags = 5;
+   */
+  tags: string[] = [];
+
+  /**
+   * 上传者，写入文档 metadata.uploader
+   *
+   * @generated from field: string uploader = 6;
+   */
+  uploader = "";
+
+  constructor(data?: PartialMessage<UploadPdfRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.UploadPdfRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "file_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "filename", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "dedupe_policy", kind: "enum", T: proto3.getEnumType(DedupePolicy) },
+    { no: 4, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "uploader", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadPdfRequest {
+    return new UploadPdfRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadPdfRequest {
+    return new UploadPdfRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadPdfRequest {
+    return new UploadPdfRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadPdfRequest | PlainMessage<UploadPdfRequest> | undefined, b: UploadPdfRequest | PlainMessage<UploadPdfRequest> | undefined): boolean {
+    return proto3.util.equals(UploadPdfRequest, a, b);
+  }
+}
+
+/**
+ * 上传PDF响应
+ *
+ * @generated from message rag.v1.UploadPdfResponse
+ */
+export class UploadPdfResponse extends Message<UploadPdfResponse> {
+  /**
+   * 任务是否提交成功
+   *
+   * @generated from field: bool success = 1;
+   */
+  success = false;
+
+  /**
+   * 处理结果消息
+   *
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * 文档唯一标识ID：内容重复时为已有文档，否则任务完成后通过 GetIngestionJob 获取
+   *
+   * @generated from field: string document_id = 3;
+   */
+  documentId = "";
+
+  /**
+   * 摄取任务ID，内容重复且未提交任务时为空
+   *
+   * @generated from field: string job_id = 4;
+   */
+  jobId = "";
+
+  /**
+   * 重复内容处理结果
+   *
+   * @generated from field: rag.v1.DedupeResult dedupe_result = 5;
+   */
+  dedupeResult = DedupeResult.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<UploadPdfResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.UploadPdfResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "document_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "dedupe_result", kind: "enum", T: proto3.getEnumType(DedupeResult) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadPdfResponse {
+    return new UploadPdfResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadPdfResponse {
+    return new UploadPdfResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadPdfResponse {
+    return new UploadPdfResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadPdfResponse | PlainMessage<UploadPdfResponse> | undefined, b: UploadPdfResponse | PlainMessage<UploadPdfResponse> | undefined): boolean {
+    return proto3.util.equals(UploadPdfResponse, a, b);
+  }
+}
+
+/**
+ * 上传文档请求
+ *
+ * @generated from message rag.v1.UploadDocumentRequest
+ */
+export class UploadDocumentRequest extends Message<UploadDocumentRequest> {
+  /**
+   * 文件键不能为空
+   *
+   * @generated from field: string file_key = 1;
+   */
+  fileKey = "";
+
+  /**
+   * 文件名，按扩展名选择解析器
+   *
+   * @generated from field: string filename = 2;
+   */
+  filename = "";
+
+  /**
+   * MIME 类型，扩展名无法识别时用于选择解析器
+   *
+   * @generated from field: string content_type = 3;
+   */
+  contentType = "";
+
+  /**
+   * 重复内容处理策略，未指定时使用服务端配置
+   *
+   * @generated from field: rag.v1.DedupePolicy dedupe_policy = 4;
+   */
+  dedupePolicy = DedupePolicy.UNSPECIFIED;
+
+  /**
+   * 所属集合 ID，为空表示不属于任何集合
+   *
+   * @generated from field: string collection_id = 5;
+   */
+  collectionId = "";
+
+  /**
+   * 文档标签，写入文档 metadata.tags，可用 SearchFilter.tags 过滤
+   *
+   * @generated from field: repeated string tags = 6;
+   */
+  tags: string[] = [];
+
+  /**
+   * 上传者，写入文档 metadata.uploader
+   *
+   * @generated from field: string uploader = 7;
+   */
+  uploader = "";
+
+  constructor(data?: PartialMessage<UploadDocumentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.UploadDocumentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "file_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "filename", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "dedupe_policy", kind: "enum", T: proto3.getEnumType(DedupePolicy) },
+    { no: 5, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "uploader", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadDocumentRequest {
+    return new UploadDocumentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadDocumentRequest {
+    return new UploadDocumentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadDocumentRequest {
+    return new UploadDocumentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadDocumentRequest | PlainMessage<UploadDocumentRequest> | undefined, b: UploadDocumentRequest | PlainMessage<UploadDocumentRequest> | undefined): boolean {
+    return proto3.util.equals(UploadDocumentRequest, a, b);
+  }
+}
+
+/**
+ * 上传文档响应
+ *
+ * @generated from message rag.v1.UploadDocumentResponse
+ */
+export class UploadDocumentResponse extends Message<UploadDocumentResponse> {
+  /**
+   * 任务是否提交成功
+   *
+   * @generated from field: bool success = 1;
+   */
+  success = false;
+
+  /**
+   * 处理结果消息
+   *
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * 文档唯一标识ID：内容重复时为已有文档，否则任务完成后通过 GetIngestionJob 获取
+   *
+   * @generated from field: string document_id = 3;
+   */
+  documentId = "";
+
+  /**
+   * 摄取任务ID，内容重复且未提交任务时为空
+   *
+   * @generated from field: string job_id = 4;
+   */
+  jobId = "";
+
+  /**
+   * 重复内容处理结果
+   *
+   * @generated from field: rag.v1.DedupeResult dedupe_result = 5;
+   */
+  dedupeResult = DedupeResult.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<UploadDocumentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.UploadDocumentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "document_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "dedupe_result", kind: "enum", T: proto3.getEnumType(DedupeResult) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadDocumentResponse {
+    return new UploadDocumentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadDocumentResponse {
+    return new UploadDocumentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadDocumentResponse {
+    return new UploadDocumentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadDocumentResponse | PlainMessage<UploadDocumentResponse> | undefined, b: UploadDocumentResponse | PlainMessage<UploadDocumentResponse> | undefined): boolean {
+    return proto3.util.equals(UploadDocumentResponse, a, b);
+  }
+}
+
+/**
+ * IngestionJob 摄取任务视图
+ *
+ * @generated from message rag.v1.IngestionJob
+ */
+export class IngestionJob extends Message<IngestionJob> {
+  /**
+   * 任务 ID
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * 存储键
+   *
+   * @generated from field: string file_key = 2;
+   */
+  fileKey = "";
+
+  /**
+   * 文件名
+   *
+   * @generated from field: string filename = 3;
+   */
+  filename = "";
+
+  /**
+   * 任务状态
+   *
+   * @generated from field: rag.v1.IngestionJobStatus status = 4;
+   */
+  status = IngestionJobStatus.UNSPECIFIED;
+
+  /**
+   * 当前阶段
+   *
+   * @generated from field: rag.v1.IngestionStage stage = 5;
+   */
+  stage = IngestionStage.UNSPECIFIED;
+
+  /**
+   * 总体进度百分比（0-100）
+   *
+   * @generated from field: int32 progress = 6;
+   */
+  progress = 0;
+
+  /**
+   * 分块总数
+   *
+   * @generated from field: int32 total_chunks = 7;
+   */
+  totalChunks = 0;
+
+  /**
+   * 已成功入库的分块数
+   *
+   * @generated from field: int32 processed_chunks = 8;
+   */
+  processedChunks = 0;
+
+  /**
+   * 入库失败的分块数
+   *
+   * @generated from field: int32 failed_chunks = 9;
+   */
+  failedChunks = 0;
+
+  /**
+   * 生成的文档 ID
+   *
+   * @generated from field: string document_id = 10;
+   */
+  documentId = "";
+
+  /**
+   * 失败原因
+   *
+   * @generated from field: string error = 11;
+   */
+  error = "";
+
+  /**
+   * 已尝试次数
+   *
+   * @generated from field: int32 attempts = 12;
+   */
+  attempts = 0;
+
+  /**
+   * 创建时间（RFC3339）
+   *
+   * @generated from field: string created_at = 13;
+   */
+  createdAt = "";
+
+  /**
+   * 最近更新时间（RFC3339）
+   *
+   * @generated from field: string updated_at = 14;
+   */
+  updatedAt = "";
+
+  /**
+   * 完成时间（RFC3339），未完成时为空
+   *
+   * @generated from field: string finished_at = 15;
+   */
+  finishedAt = "";
+
+  /**
+   * 所属集合 ID
+   *
+   * @generated from field: string collection_id = 16;
+   */
+  collectionId = "";
+
+  /**
+   * 文档标签
+   *
+   * @generated from field: repeated string tags = 17;
+   */
+  tags: string[] = [];
+
+  /**
+   * 上传者
+   *
+   * @generated from field: string uploader = 18;
+   */
+  uploader = "";
+
+  constructor(data?: PartialMessage<IngestionJob>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.IngestionJob";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "file_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "filename", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(IngestionJobStatus) },
+    { no: 5, name: "stage", kind: "enum", T: proto3.getEnumType(IngestionStage) },
+    { no: 6, name: "progress", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "total_chunks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "processed_chunks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "failed_chunks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "document_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 13, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "finished_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 17, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 18, name: "uploader", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngestionJob {
+    return new IngestionJob().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngestionJob {
+    return new IngestionJob().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngestionJob {
+    return new IngestionJob().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngestionJob | PlainMessage<IngestionJob> | undefined, b: IngestionJob | PlainMessage<IngestionJob> | undefined): boolean {
+    return proto3.util.equals(IngestionJob, a, b);
+  }
+}
+
+/**
+ * GetIngestionJobRequest 查询摄取任务请求
+ *
+ * @generated from message rag.v1.GetIngestionJobRequest
+ */
+export class GetIngestionJobRequest extends Message<GetIngestionJobRequest> {
+  /**
+   * 任务 ID
+   *
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  constructor(data?: PartialMessage<GetIngestionJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.GetIngestionJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetIngestionJobRequest {
+    return new GetIngestionJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetIngestionJobRequest {
+    return new GetIngestionJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetIngestionJobRequest {
+    return new GetIngestionJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetIngestionJobRequest | PlainMessage<GetIngestionJobRequest> | undefined, b: GetIngestionJobRequest | PlainMessage<GetIngestionJobRequest> | undefined): boolean {
+    return proto3.util.equals(GetIngestionJobRequest, a, b);
+  }
+}
+
+/**
+ * GetIngestionJobResponse 查询摄取任务响应
+ *
+ * @generated from message rag.v1.GetIngestionJobResponse
+ */
+export class GetIngestionJobResponse extends Message<GetIngestionJobResponse> {
+  /**
+   * 任务
+   *
+   * @generated from field: rag.v1.IngestionJob job = 1;
+   */
+  job?: IngestionJob;
+
+  constructor(data?: PartialMessage<GetIngestionJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.GetIngestionJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job", kind: "message", T: IngestionJob },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetIngestionJobResponse {
+    return new GetIngestionJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetIngestionJobResponse {
+    return new GetIngestionJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetIngestionJobResponse {
+    return new GetIngestionJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetIngestionJobResponse | PlainMessage<GetIngestionJobResponse> | undefined, b: GetIngestionJobResponse | PlainMessage<GetIngestionJobResponse> | undefined): boolean {
+    return proto3.util.equals(GetIngestionJobResponse, a, b);
+  }
+}
+
+/**
+ * ListIngestionJobsRequest 摄取任务列表请求（游标分页）
+ *
+ * @generated from message rag.v1.ListIngestionJobsRequest
+ */
+export class ListIngestionJobsRequest extends Message<ListIngestionJobsRequest> {
+  /**
+   * 页面大小，默认 50，最大 200
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize = 0;
+
+  /**
+   * 游标（上一页返回的 next_cursor）
+   *
+   * @generated from field: string cursor = 2;
+   */
+  cursor = "";
+
+  /**
+   * 按状态过滤，不指定时返回全部
+   *
+   * @generated from field: rag.v1.IngestionJobStatus status = 3;
+   */
+  status = IngestionJobStatus.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ListIngestionJobsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ListIngestionJobsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(IngestionJobStatus) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListIngestionJobsRequest {
+    return new ListIngestionJobsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListIngestionJobsRequest {
+    return new ListIngestionJobsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListIngestionJobsRequest {
+    return new ListIngestionJobsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListIngestionJobsRequest | PlainMessage<ListIngestionJobsRequest> | undefined, b: ListIngestionJobsRequest | PlainMessage<ListIngestionJobsRequest> | undefined): boolean {
+    return proto3.util.equals(ListIngestionJobsRequest, a, b);
+  }
+}
+
+/**
+ * ListIngestionJobsResponse 摄取任务列表响应
+ *
+ * @generated from message rag.v1.ListIngestionJobsResponse
+ */
+export class ListIngestionJobsResponse extends Message<ListIngestionJobsResponse> {
+  /**
+   * 任务
+   *
+   * @generated from field: repeated rag.v1.IngestionJob jobs = 1;
+   */
+  jobs: IngestionJob[] = [];
+
+  /**
+   * 下一页游标，如为空表示没有更多
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor = "";
+
+  constructor(data?: PartialMessage<ListIngestionJobsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ListIngestionJobsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "jobs", kind: "message", T: IngestionJob, repeated: true },
+    { no: 2, name: "next_cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListIngestionJobsResponse {
+    return new ListIngestionJobsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListIngestionJobsResponse {
+    return new ListIngestionJobsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListIngestionJobsResponse {
+    return new ListIngestionJobsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListIngestionJobsResponse | PlainMessage<ListIngestionJobsResponse> | undefined, b: ListIngestionJobsResponse | PlainMessage<ListIngestionJobsResponse> | undefined): boolean {
+    return proto3.util.equals(ListIngestionJobsResponse, a, b);
+  }
+}
+
+/**
+ * 获取上下文请求
+ *
+ * @generated from message rag.v1.GetContextRequest
+ */
+export class GetContextRequest extends Message<GetContextRequest> {
+  /**
+   * 查询字符串不能为空且长度限制
+   *
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  /**
+   * 检索过滤条件，为空时检索全部文档
+   *
+   * @generated from field: rag.v1.SearchFilter filter = 2;
+   */
+  filter?: SearchFilter;
+
+  /**
+   * 限定检索的集合 ID，为空时检索全部文档
+   *
+   * @generated from field: string collection_id = 3;
+   */
+  collectionId = "";
+
+  /**
+   * 向量索引查询参数，为空时使用服务端配置
+   *
+   * @generated from field: rag.v1.AnnOptions ann = 4;
+   */
+  ann?: AnnOptions;
+
+  /**
+   * 关键词提取和总结使用的模型，须在服务端 allowed_models 中；为空时使用各提供方的默认模型
+   *
+   * @generated from field: string model = 5;
+   */
+  model = "";
+
+  constructor(data?: PartialMessage<GetContextRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.GetContextRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "filter", kind: "message", T: SearchFilter },
+    { no: 3, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "ann", kind: "message", T: AnnOptions },
+    { no: 5, name: "model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetContextRequest {
+    return new GetContextRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetContextRequest {
+    return new GetContextRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetContextRequest {
+    return new GetContextRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetContextRequest | PlainMessage<GetContextRequest> | undefined, b: GetContextRequest | PlainMessage<GetContextRequest> | undefined): boolean {
+    return proto3.util.equals(GetContextRequest, a, b);
+  }
+}
+
+/**
+ * AnnOptions 近似最近邻索引的查询参数，只作用于当前请求
+ *
+ * @generated from message rag.v1.AnnOptions
+ */
+export class AnnOptions extends Message<AnnOptions> {
+  /**
+   * HNSW 搜索候选数（hnsw.ef_search），0 表示使用服务端配置；
+   * 实际值不小于候选分块数，否则索引返回的结果不足
+   *
+   * @generated from field: int32 ef_search = 1;
+   */
+  efSearch = 0;
+
+  /**
+   * IVFFlat 探测的列表数（ivfflat.probes），0 表示使用服务端配置
+   *
+   * @generated from field: int32 probes = 2;
+   */
+  probes = 0;
+
+  constructor(data?: PartialMessage<AnnOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.AnnOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ef_search", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "probes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AnnOptions {
+    return new AnnOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AnnOptions {
+    return new AnnOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AnnOptions {
+    return new AnnOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AnnOptions | PlainMessage<AnnOptions> | undefined, b: AnnOptions | PlainMessage<AnnOptions> | undefined): boolean {
+    return proto3.util.equals(AnnOptions, a, b);
+  }
+}
+
+/**
+ * SearchFilter 检索过滤条件，各条件之间为“与”关系，在向量和全文检索的 SQL 内部生效
+ *
+ * @generated from message rag.v1.SearchFilter
+ */
+export class SearchFilter extends Message<SearchFilter> {
+  /**
+   * 限定文档 ID
+   *
+   * @generated from field: repeated string document_ids = 1;
+   */
+  documentIds: string[] = [];
+
+  /**
+   * 文档 metadata.tags 需包含的全部标签
+   *
+   * @generated from field: repeated string tags = 2;
+   */
+  tags: string[] = [];
+
+  /**
+   * 文档 metadata.uploader 需等于该值
+   *
+   * @generated from field: string uploader = 3;
+   */
+  uploader = "";
+
+  /**
+   * 文档创建时间下界（RFC3339，含边界）
+   *
+   * @generated from field: string created_after = 4;
+   */
+  createdAfter = "";
+
+  /**
+   * 文档创建时间上界（RFC3339，含边界）
+   *
+   * @generated from field: string created_before = 5;
+   */
+  createdBefore = "";
+
+  /**
+   * 任意元数据条件
+   *
+   * @generated from field: repeated rag.v1.MetadataCondition metadata = 6;
+   */
+  metadata: MetadataCondition[] = [];
+
+  constructor(data?: PartialMessage<SearchFilter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.SearchFilter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "document_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "uploader", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_after", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_before", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "metadata", kind: "message", T: MetadataCondition, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchFilter {
+    return new SearchFilter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchFilter {
+    return new SearchFilter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchFilter {
+    return new SearchFilter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchFilter | PlainMessage<SearchFilter> | undefined, b: SearchFilter | PlainMessage<SearchFilter> | undefined): boolean {
+    return proto3.util.equals(SearchFilter, a, b);
+  }
+}
+
+/**
+ * MetadataCondition 作用于 JSONB metadata 的单个条件
+ *
+ * @generated from message rag.v1.MetadataCondition
+ */
+export class MetadataCondition extends Message<MetadataCondition> {
+  /**
+   * 条件作用的表，未指定时为文档
+   *
+   * @generated from field: rag.v1.MetadataScope scope = 1;
+   */
+  scope = MetadataScope.UNSPECIFIED;
+
+  /**
+   * 键路径，如 ["source", "lang"] 对应 metadata.source.lang
+   *
+   * @generated from field: repeated string path = 2;
+   */
+  path: string[] = [];
+
+  /**
+   * 比较运算符
+   *
+   * @generated from field: rag.v1.MetadataOperator operator = 3;
+   */
+  operator = MetadataOperator.UNSPECIFIED;
+
+  /**
+   * JSON 编码的比较值，字符串需带引号，如 `"zh"`、`3`、`true`；IN 可有多个，EXISTS 不需要，其余恰好一个
+   *
+   * @generated from field: repeated string values_json = 4;
+   */
+  valuesJson: string[] = [];
+
+  constructor(data?: PartialMessage<MetadataCondition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.MetadataCondition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scope", kind: "enum", T: proto3.getEnumType(MetadataScope) },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "operator", kind: "enum", T: proto3.getEnumType(MetadataOperator) },
+    { no: 4, name: "values_json", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataCondition {
+    return new MetadataCondition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataCondition {
+    return new MetadataCondition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataCondition {
+    return new MetadataCondition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataCondition | PlainMessage<MetadataCondition> | undefined, b: MetadataCondition | PlainMessage<MetadataCondition> | undefined): boolean {
+    return proto3.util.equals(MetadataCondition, a, b);
+  }
+}
+
+/**
+ * 获取上下文响应
+ *
+ * @generated from message rag.v1.GetContextResponse
+ */
+export class GetContextResponse extends Message<GetContextResponse> {
+  /**
+   * 检索到的相关上下文内容（原始文本，rag_answer 为空时作为回退展示）
+   *
+   * @generated from field: string context = 1;
+   */
+  context = "";
+
+  /**
+   * 关键词
+   *
+   * @generated from field: repeated string keywords = 2;
+   */
+  keywords: string[] = [];
+
+  /**
+   * 参与生成回答的分块，按最终排序排列，可用于引用溯源
+   *
+   * @generated from field: repeated rag.v1.RetrievedChunk chunks = 3;
+   */
+  chunks: RetrievedChunk[] = [];
+
+  /**
+   * 结构化回答，大模型输出无法解析或使用模板回答时为空
+   *
+   * @generated from field: rag.v1.RagAnswer rag_answer = 4;
+   */
+  ragAnswer?: RagAnswer;
+
+  constructor(data?: PartialMessage<GetContextResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.GetContextResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "context", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "keywords", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "chunks", kind: "message", T: RetrievedChunk, repeated: true },
+    { no: 4, name: "rag_answer", kind: "message", T: RagAnswer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetContextResponse {
+    return new GetContextResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetContextResponse {
+    return new GetContextResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetContextResponse {
+    return new GetContextResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetContextResponse | PlainMessage<GetContextResponse> | undefined, b: GetContextResponse | PlainMessage<GetContextResponse> | undefined): boolean {
+    return proto3.util.equals(GetContextResponse, a, b);
+  }
+}
+
+/**
+ * RagAnswer 从大模型输出的 <rag_response> XML 解析出的结构化回答
+ *
+ * @generated from message rag.v1.RagAnswer
+ */
+export class RagAnswer extends Message<RagAnswer> {
+  /**
+   * 一句话概括检索内容的共同主题
+   *
+   * @generated from field: string summary = 1;
+   */
+  summary = "";
+
+  /**
+   * 核心信息点
+   *
+   * @generated from field: repeated rag.v1.AnswerPoint info_points = 2;
+   */
+  infoPoints: AnswerPoint[] = [];
+
+  /**
+   * 详细内容章节
+   *
+   * @generated from field: repeated rag.v1.AnswerPoint sections = 3;
+   */
+  sections: AnswerPoint[] = [];
+
+  /**
+   * 关键要点
+   *
+   * @generated from field: repeated string key_points = 4;
+   */
+  keyPoints: string[] = [];
+
+  /**
+   * 信息完整性评估
+   *
+   * @generated from field: rag.v1.AnswerCompleteness completeness = 5;
+   */
+  completeness?: AnswerCompleteness;
+
+  /**
+   * 引用来源
+   *
+   * @generated from field: repeated rag.v1.AnswerSource sources = 6;
+   */
+  sources: AnswerSource[] = [];
+
+  /**
+   * 原始输出格式不合法，经修复或重新生成后才解析成功
+   *
+   * @generated from field: bool repaired = 7;
+   */
+  repaired = false;
+
+  constructor(data?: PartialMessage<RagAnswer>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.RagAnswer";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "summary", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "info_points", kind: "message", T: AnswerPoint, repeated: true },
+    { no: 3, name: "sections", kind: "message", T: AnswerPoint, repeated: true },
+    { no: 4, name: "key_points", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "completeness", kind: "message", T: AnswerCompleteness },
+    { no: 6, name: "sources", kind: "message", T: AnswerSource, repeated: true },
+    { no: 7, name: "repaired", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RagAnswer {
+    return new RagAnswer().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RagAnswer {
+    return new RagAnswer().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RagAnswer {
+    return new RagAnswer().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RagAnswer | PlainMessage<RagAnswer> | undefined, b: RagAnswer | PlainMessage<RagAnswer> | undefined): boolean {
+    return proto3.util.equals(RagAnswer, a, b);
+  }
+}
+
+/**
+ * AnswerPoint 带标题的回答内容
+ *
+ * @generated from message rag.v1.AnswerPoint
+ */
+export class AnswerPoint extends Message<AnswerPoint> {
+  /**
+   * 标题
+   *
+   * @generated from field: string title = 1;
+   */
+  title = "";
+
+  /**
+   * 内容
+   *
+   * @generated from field: string content = 2;
+   */
+  content = "";
+
+  constructor(data?: PartialMessage<AnswerPoint>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.AnswerPoint";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AnswerPoint {
+    return new AnswerPoint().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AnswerPoint {
+    return new AnswerPoint().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AnswerPoint {
+    return new AnswerPoint().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AnswerPoint | PlainMessage<AnswerPoint> | undefined, b: AnswerPoint | PlainMessage<AnswerPoint> | undefined): boolean {
+    return proto3.util.equals(AnswerPoint, a, b);
+  }
+}
+
+/**
+ * AnswerCompleteness 检索信息对查询的覆盖程度
+ *
+ * @generated from message rag.v1.AnswerCompleteness
+ */
+export class AnswerCompleteness extends Message<AnswerCompleteness> {
+  /**
+   * 完整性评估
+   *
+   * @generated from field: string assessment = 1;
+   */
+  assessment = "";
+
+  /**
+   * 上下文中缺失的信息
+   *
+   * @generated from field: string missing_info = 2;
+   */
+  missingInfo = "";
+
+  constructor(data?: PartialMessage<AnswerCompleteness>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.AnswerCompleteness";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "assessment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "missing_info", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AnswerCompleteness {
+    return new AnswerCompleteness().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AnswerCompleteness {
+    return new AnswerCompleteness().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AnswerCompleteness {
+    return new AnswerCompleteness().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AnswerCompleteness | PlainMessage<AnswerCompleteness> | undefined, b: AnswerCompleteness | PlainMessage<AnswerCompleteness> | undefined): boolean {
+    return proto3.util.equals(AnswerCompleteness, a, b);
+  }
+}
+
+/**
+ * AnswerSource 回答引用的信息来源
+ *
+ * @generated from message rag.v1.AnswerSource
+ */
+export class AnswerSource extends Message<AnswerSource> {
+  /**
+   * 来源标识
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * 相似度得分
+   *
+   * @generated from field: double similarity = 2;
+   */
+  similarity = 0;
+
+  /**
+   * 该信息片段的摘要
+   *
+   * @generated from field: string summary = 3;
+   */
+  summary = "";
+
+  constructor(data?: PartialMessage<AnswerSource>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.AnswerSource";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "similarity", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "summary", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AnswerSource {
+    return new AnswerSource().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AnswerSource {
+    return new AnswerSource().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AnswerSource {
+    return new AnswerSource().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AnswerSource | PlainMessage<AnswerSource> | undefined, b: AnswerSource | PlainMessage<AnswerSource> | undefined): boolean {
+    return proto3.util.equals(AnswerSource, a, b);
+  }
+}
+
+/**
+ * RetrievedChunk 检索命中的文档分块及其评分明细
+ *
+ * @generated from message rag.v1.RetrievedChunk
+ */
+export class RetrievedChunk extends Message<RetrievedChunk> {
+  /**
+   * 文档 ID
+   *
+   * @generated from field: string document_id = 1;
+   */
+  documentId = "";
+
+  /**
+   * 分块 ID
+   *
+   * @generated from field: string chunk_id = 2;
+   */
+  chunkId = "";
+
+  /**
+   * 分块在文档中的序号
+   *
+   * @generated from field: int32 chunk_index = 3;
+   */
+  chunkIndex = 0;
+
+  /**
+   * 文档标题
+   *
+   * @generated from field: string title = 4;
+   */
+  title = "";
+
+  /**
+   * 分块所属章节标题
+   *
+   * @generated from field: string section_title = 5;
+   */
+  sectionTitle = "";
+
+  /**
+   * 向量余弦相似度
+   *
+   * @generated from field: float similarity = 6;
+   */
+  similarity = 0;
+
+  /**
+   * 混合检索得分（未启用混合检索时为 0）
+   *
+   * @generated from field: double hybrid_score = 7;
+   */
+  hybridScore = 0;
+
+  /**
+   * 关键词重排序得分
+   *
+   * @generated from field: double advanced_score = 8;
+   */
+  advancedScore = 0;
+
+  /**
+   * 分块文本
+   *
+   * @generated from field: string text = 9;
+   */
+  text = "";
+
+  /**
+   * 分块起始页码（从 1 开始，未知时为 0）
+   *
+   * @generated from field: int32 page_start = 10;
+   */
+  pageStart = 0;
+
+  /**
+   * 分块结束页码（从 1 开始，未知时为 0）
+   *
+   * @generated from field: int32 page_end = 11;
+   */
+  pageEnd = 0;
+
+  /**
+   * 分块在文档文本中的起始字符偏移（不含页码标记）
+   *
+   * @generated from field: int32 char_start = 12;
+   */
+  charStart = 0;
+
+  /**
+   * 分块在文档文本中的结束字符偏移（不含页码标记）
+   *
+   * @generated from field: int32 char_end = 13;
+   */
+  charEnd = 0;
+
+  /**
+   * 重排序模型相关性得分（未启用重排序模型或调用失败时为 0）
+   *
+   * @generated from field: double rerank_score = 14;
+   */
+  rerankScore = 0;
+
+  /**
+   * 分块因超出上下文 token 预算未放入总结提示词
+   *
+   * @generated from field: bool context_dropped = 15;
+   */
+  contextDropped = false;
+
+  constructor(data?: PartialMessage<RetrievedChunk>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.RetrievedChunk";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "document_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "chunk_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "chunk_index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "section_title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "similarity", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 7, name: "hybrid_score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "advanced_score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 9, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "page_start", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "page_end", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "char_start", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 13, name: "char_end", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 14, name: "rerank_score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 15, name: "context_dropped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetrievedChunk {
+    return new RetrievedChunk().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetrievedChunk {
+    return new RetrievedChunk().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetrievedChunk {
+    return new RetrievedChunk().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetrievedChunk | PlainMessage<RetrievedChunk> | undefined, b: RetrievedChunk | PlainMessage<RetrievedChunk> | undefined): boolean {
+    return proto3.util.equals(RetrievedChunk, a, b);
+  }
+}
+
+/**
+ * 检索请求
+ *
+ * @generated from message rag.v1.SearchRequest
+ */
+export class SearchRequest extends Message<SearchRequest> {
+  /**
+   * 查询字符串不能为空且长度限制
+   *
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  /**
+   * 返回的最大分块数，0 表示使用默认值 5
+   *
+   * @generated from field: int32 top_k = 2;
+   */
+  topK = 0;
+
+  /**
+   * 最低相似度阈值，0 表示使用默认值 0.25
+   *
+   * @generated from field: float min_similarity = 3;
+   */
+  minSimilarity = 0;
+
+  /**
+   * 是否使用大模型提取关键词，否则使用本地分词提取
+   *
+   * @generated from field: bool use_llm_keywords = 4;
+   */
+  useLlmKeywords = false;
+
+  /**
+   * 检索过滤条件，为空时检索全部文档
+   *
+   * @generated from field: rag.v1.SearchFilter filter = 5;
+   */
+  filter?: SearchFilter;
+
+  /**
+   * 限定检索的集合 ID，为空时检索全部文档
+   *
+   * @generated from field: string collection_id = 6;
+   */
+  collectionId = "";
+
+  /**
+   * 向量索引查询参数，为空时使用服务端配置
+   *
+   * @generated from field: rag.v1.AnnOptions ann = 7;
+   */
+  ann?: AnnOptions;
+
+  constructor(data?: PartialMessage<SearchRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.SearchRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "top_k", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "min_similarity", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 4, name: "use_llm_keywords", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "filter", kind: "message", T: SearchFilter },
+    { no: 6, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "ann", kind: "message", T: AnnOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchRequest {
+    return new SearchRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchRequest {
+    return new SearchRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchRequest {
+    return new SearchRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchRequest | PlainMessage<SearchRequest> | undefined, b: SearchRequest | PlainMessage<SearchRequest> | undefined): boolean {
+    return proto3.util.equals(SearchRequest, a, b);
+  }
+}
+
+/**
+ * 检索响应
+ *
+ * @generated from message rag.v1.SearchResponse
+ */
+export class SearchResponse extends Message<SearchResponse> {
+  /**
+   * 排序后的分块
+   *
+   * @generated from field: repeated rag.v1.RetrievedChunk chunks = 1;
+   */
+  chunks: RetrievedChunk[] = [];
+
+  /**
+   * 检索使用的关键词
+   *
+   * @generated from field: repeated string keywords = 2;
+   */
+  keywords: string[] = [];
+
+  constructor(data?: PartialMessage<SearchResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.SearchResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "chunks", kind: "message", T: RetrievedChunk, repeated: true },
+    { no: 2, name: "keywords", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SearchResponse {
+    return new SearchResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SearchResponse {
+    return new SearchResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SearchResponse {
+    return new SearchResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SearchResponse | PlainMessage<SearchResponse> | undefined, b: SearchResponse | PlainMessage<SearchResponse> | undefined): boolean {
+    return proto3.util.equals(SearchResponse, a, b);
+  }
+}
+
+/**
+ * 对话请求
+ *
+ * @generated from message rag.v1.ChatRequest
+ */
+export class ChatRequest extends Message<ChatRequest> {
+  /**
+   * 会话 ID，为空时创建新会话
+   *
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * 用户本轮消息
+   *
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * 限定检索的集合 ID，为空时检索全部文档
+   *
+   * @generated from field: string collection_id = 3;
+   */
+  collectionId = "";
+
+  constructor(data?: PartialMessage<ChatRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ChatRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChatRequest {
+    return new ChatRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChatRequest {
+    return new ChatRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChatRequest {
+    return new ChatRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChatRequest | PlainMessage<ChatRequest> | undefined, b: ChatRequest | PlainMessage<ChatRequest> | undefined): boolean {
+    return proto3.util.equals(ChatRequest, a, b);
+  }
+}
+
+/**
+ * 对话响应
+ *
+ * @generated from message rag.v1.ChatResponse
+ */
+export class ChatResponse extends Message<ChatResponse> {
+  /**
+   * 会话 ID，后续轮次需携带
+   *
+   * @generated from field: string session_id = 1;
+   */
+  sessionId = "";
+
+  /**
+   * 本轮回答
+   *
+   * @generated from field: string answer = 2;
+   */
+  answer = "";
+
+  /**
+   * 结合历史改写后的独立查询，用于检索
+   *
+   * @generated from field: string standalone_query = 3;
+   */
+  standaloneQuery = "";
+
+  /**
+   * 关键词
+   *
+   * @generated from field: repeated string keywords = 4;
+   */
+  keywords: string[] = [];
+
+  /**
+   * 参与生成回答的分块
+   *
+   * @generated from field: repeated rag.v1.RetrievedChunk chunks = 5;
+   */
+  chunks: RetrievedChunk[] = [];
+
+  /**
+   * 结构化回答，大模型输出无法解析或使用模板回答时为空
+   *
+   * @generated from field: rag.v1.RagAnswer rag_answer = 6;
+   */
+  ragAnswer?: RagAnswer;
+
+  constructor(data?: PartialMessage<ChatResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ChatResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "session_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "answer", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "standalone_query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "keywords", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "chunks", kind: "message", T: RetrievedChunk, repeated: true },
+    { no: 6, name: "rag_answer", kind: "message", T: RagAnswer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChatResponse {
+    return new ChatResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChatResponse {
+    return new ChatResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChatResponse {
+    return new ChatResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChatResponse | PlainMessage<ChatResponse> | undefined, b: ChatResponse | PlainMessage<ChatResponse> | undefined): boolean {
+    return proto3.util.equals(ChatResponse, a, b);
+  }
+}
+
+/**
+ * 流式获取上下文请求
+ *
+ * @generated from message rag.v1.StreamContextRequest
+ */
+export class StreamContextRequest extends Message<StreamContextRequest> {
+  /**
+   * 查询字符串不能为空且长度限制
+   *
+   * @generated from field: string query = 1;
+   */
+  query = "";
+
+  /**
+   * 检索过滤条件，为空时检索全部文档
+   *
+   * @generated from field: rag.v1.SearchFilter filter = 2;
+   */
+  filter?: SearchFilter;
+
+  /**
+   * 限定检索的集合 ID，为空时检索全部文档
+   *
+   * @generated from field: string collection_id = 3;
+   */
+  collectionId = "";
+
+  /**
+   * 向量索引查询参数，为空时使用服务端配置
+   *
+   * @generated from field: rag.v1.AnnOptions ann = 4;
+   */
+  ann?: AnnOptions;
+
+  /**
+   * 关键词提取和总结使用的模型，须在服务端 allowed_models 中；为空时使用各提供方的默认模型
+   *
+   * @generated from field: string model = 5;
+   */
+  model = "";
+
+  constructor(data?: PartialMessage<StreamContextRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.StreamContextRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "filter", kind: "message", T: SearchFilter },
+    { no: 3, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "ann", kind: "message", T: AnnOptions },
+    { no: 5, name: "model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamContextRequest {
+    return new StreamContextRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamContextRequest {
+    return new StreamContextRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamContextRequest {
+    return new StreamContextRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamContextRequest | PlainMessage<StreamContextRequest> | undefined, b: StreamContextRequest | PlainMessage<StreamContextRequest> | undefined): boolean {
+    return proto3.util.equals(StreamContextRequest, a, b);
+  }
+}
+
+/**
+ * 流式获取上下文事件
+ *
+ * @generated from message rag.v1.StreamContextResponse
+ */
+export class StreamContextResponse extends Message<StreamContextResponse> {
+  /**
+   * 事件所属阶段
+   *
+   * @generated from field: rag.v1.ContextStage stage = 1;
+   */
+  stage = ContextStage.UNSPECIFIED;
+
+  /**
+   * 自请求开始经过的毫秒数
+   *
+   * @generated from field: int64 elapsed_ms = 2;
+   */
+  elapsedMs = protoInt64.zero;
+
+  /**
+   * 事件内容
+   *
+   * @generated from oneof rag.v1.StreamContextResponse.event
+   */
+  event: {
+    /**
+     * 关键词提取完成
+     *
+     * @generated from field: rag.v1.KeywordsReady keywords_ready = 3;
+     */
+    value: KeywordsReady;
+    case: "keywordsReady";
+  } | {
+    /**
+     * 查询向量生成完成
+     *
+     * @generated from field: rag.v1.EmbeddingReady embedding_ready = 4;
+     */
+    value: EmbeddingReady;
+    case: "embeddingReady";
+  } | {
+    /**
+     * 向量检索完成
+     *
+     * @generated from field: rag.v1.ChunksFound chunks_found = 5;
+     */
+    value: ChunksFound;
+    case: "chunksFound";
+  } | {
+    /**
+     * 重排序完成
+     *
+     * @generated from field: rag.v1.ChunksReranked chunks_reranked = 6;
+     */
+    value: ChunksReranked;
+    case: "chunksReranked";
+  } | {
+    /**
+     * 总结增量片段
+     *
+     * @generated from field: rag.v1.SummaryDelta summary_delta = 7;
+     */
+    value: SummaryDelta;
+    case: "summaryDelta";
+  } | {
+    /**
+     * 处理完成
+     *
+     * @generated from field: rag.v1.ContextDone done = 8;
+     */
+    value: ContextDone;
+    case: "done";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<StreamContextResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.StreamContextResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "enum", T: proto3.getEnumType(ContextStage) },
+    { no: 2, name: "elapsed_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "keywords_ready", kind: "message", T: KeywordsReady, oneof: "event" },
+    { no: 4, name: "embedding_ready", kind: "message", T: EmbeddingReady, oneof: "event" },
+    { no: 5, name: "chunks_found", kind: "message", T: ChunksFound, oneof: "event" },
+    { no: 6, name: "chunks_reranked", kind: "message", T: ChunksReranked, oneof: "event" },
+    { no: 7, name: "summary_delta", kind: "message", T: SummaryDelta, oneof: "event" },
+    { no: 8, name: "done", kind: "message", T: ContextDone, oneof: "event" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamContextResponse {
+    return new StreamContextResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamContextResponse {
+    return new StreamContextResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamContextResponse {
+    return new StreamContextResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamContextResponse | PlainMessage<StreamContextResponse> | undefined, b: StreamContextResponse | PlainMessage<StreamContextResponse> | undefined): boolean {
+    return proto3.util.equals(StreamContextResponse, a, b);
+  }
+}
+
+/**
+ * KeywordsReady 关键词提取完成事件
+ *
+ * @generated from message rag.v1.KeywordsReady
+ */
+export class KeywordsReady extends Message<KeywordsReady> {
+  /**
+   * 关键词
+   *
+   * @generated from field: repeated string keywords = 1;
+   */
+  keywords: string[] = [];
+
+  constructor(data?: PartialMessage<KeywordsReady>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.KeywordsReady";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "keywords", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KeywordsReady {
+    return new KeywordsReady().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KeywordsReady {
+    return new KeywordsReady().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KeywordsReady {
+    return new KeywordsReady().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KeywordsReady | PlainMessage<KeywordsReady> | undefined, b: KeywordsReady | PlainMessage<KeywordsReady> | undefined): boolean {
+    return proto3.util.equals(KeywordsReady, a, b);
+  }
+}
+
+/**
+ * EmbeddingReady 查询向量生成完成事件
+ *
+ * @generated from message rag.v1.EmbeddingReady
+ */
+export class EmbeddingReady extends Message<EmbeddingReady> {
+  /**
+   * 向量维度
+   *
+   * @generated from field: int32 dimensions = 1;
+   */
+  dimensions = 0;
+
+  constructor(data?: PartialMessage<EmbeddingReady>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.EmbeddingReady";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dimensions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EmbeddingReady {
+    return new EmbeddingReady().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EmbeddingReady {
+    return new EmbeddingReady().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EmbeddingReady {
+    return new EmbeddingReady().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EmbeddingReady | PlainMessage<EmbeddingReady> | undefined, b: EmbeddingReady | PlainMessage<EmbeddingReady> | undefined): boolean {
+    return proto3.util.equals(EmbeddingReady, a, b);
+  }
+}
+
+/**
+ * ChunksFound 向量检索完成事件
+ *
+ * @generated from message rag.v1.ChunksFound
+ */
+export class ChunksFound extends Message<ChunksFound> {
+  /**
+   * 命中的分块数量
+   *
+   * @generated from field: int32 count = 1;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<ChunksFound>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ChunksFound";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChunksFound {
+    return new ChunksFound().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChunksFound {
+    return new ChunksFound().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChunksFound {
+    return new ChunksFound().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChunksFound | PlainMessage<ChunksFound> | undefined, b: ChunksFound | PlainMessage<ChunksFound> | undefined): boolean {
+    return proto3.util.equals(ChunksFound, a, b);
+  }
+}
+
+/**
+ * ChunksReranked 重排序完成事件
+ *
+ * @generated from message rag.v1.ChunksReranked
+ */
+export class ChunksReranked extends Message<ChunksReranked> {
+  /**
+   * 重排序后保留的分块数量
+   *
+   * @generated from field: int32 count = 1;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<ChunksReranked>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ChunksReranked";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChunksReranked {
+    return new ChunksReranked().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChunksReranked {
+    return new ChunksReranked().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChunksReranked {
+    return new ChunksReranked().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChunksReranked | PlainMessage<ChunksReranked> | undefined, b: ChunksReranked | PlainMessage<ChunksReranked> | undefined): boolean {
+    return proto3.util.equals(ChunksReranked, a, b);
+  }
+}
+
+/**
+ * SummaryDelta 总结增量片段
+ *
+ * @generated from message rag.v1.SummaryDelta
+ */
+export class SummaryDelta extends Message<SummaryDelta> {
+  /**
+   * 新增文本
+   *
+   * @generated from field: string content = 1;
+   */
+  content = "";
+
+  constructor(data?: PartialMessage<SummaryDelta>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.SummaryDelta";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SummaryDelta {
+    return new SummaryDelta().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SummaryDelta {
+    return new SummaryDelta().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SummaryDelta {
+    return new SummaryDelta().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SummaryDelta | PlainMessage<SummaryDelta> | undefined, b: SummaryDelta | PlainMessage<SummaryDelta> | undefined): boolean {
+    return proto3.util.equals(SummaryDelta, a, b);
+  }
+}
+
+/**
+ * ContextDone 处理完成事件
+ *
+ * @generated from message rag.v1.ContextDone
+ */
+export class ContextDone extends Message<ContextDone> {
+  /**
+   * 完整上下文内容，与 GetContext 返回一致；重新生成回答时与已推送的增量片段不同
+   *
+   * @generated from field: string context = 1;
+   */
+  context = "";
+
+  /**
+   * 关键词
+   *
+   * @generated from field: repeated string keywords = 2;
+   */
+  keywords: string[] = [];
+
+  /**
+   * 参与生成回答的分块
+   *
+   * @generated from field: repeated rag.v1.RetrievedChunk chunks = 3;
+   */
+  chunks: RetrievedChunk[] = [];
+
+  /**
+   * 结构化回答，大模型输出无法解析或使用模板回答时为空
+   *
+   * @generated from field: rag.v1.RagAnswer rag_answer = 4;
+   */
+  ragAnswer?: RagAnswer;
+
+  constructor(data?: PartialMessage<ContextDone>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ContextDone";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "context", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "keywords", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "chunks", kind: "message", T: RetrievedChunk, repeated: true },
+    { no: 4, name: "rag_answer", kind: "message", T: RagAnswer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ContextDone {
+    return new ContextDone().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ContextDone {
+    return new ContextDone().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ContextDone {
+    return new ContextDone().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ContextDone | PlainMessage<ContextDone> | undefined, b: ContextDone | PlainMessage<ContextDone> | undefined): boolean {
+    return proto3.util.equals(ContextDone, a, b);
+  }
+}
+
+/**
+ * ListDocumentsRequest 文档列表请求（游标分页）
+ *
+ * @generated from message rag.v1.ListDocumentsRequest
+ */
+export class ListDocumentsRequest extends Message<ListDocumentsRequest> {
+  /**
+   * 页面大小，默认 50，最大 200
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize = 0;
+
+  /**
+   * 游标（上一页返回的 next_cursor）
+   *
+   * @generated from field: string cursor = 2;
+   */
+  cursor = "";
+
+  /**
+   * 只列出该集合的文档，为空时列出全部
+   *
+   * @generated from field: string collection_id = 3;
+   */
+  collectionId = "";
+
+  constructor(data?: PartialMessage<ListDocumentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ListDocumentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDocumentsRequest {
+    return new ListDocumentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDocumentsRequest {
+    return new ListDocumentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDocumentsRequest {
+    return new ListDocumentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDocumentsRequest | PlainMessage<ListDocumentsRequest> | undefined, b: ListDocumentsRequest | PlainMessage<ListDocumentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListDocumentsRequest, a, b);
+  }
+}
+
+/**
+ * Document 文档视图
+ *
+ * @generated from message rag.v1.Document
+ */
+export class Document extends Message<Document> {
+  /**
+   * 文档 ID
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * 文档标题
+   *
+   * @generated from field: string title = 2;
+   */
+  title = "";
+
+  /**
+   * 存储键
+   *
+   * @generated from field: string minio_key = 3;
+   */
+  minioKey = "";
+
+  /**
+   * 元数据 JSON
+   *
+   * @generated from field: string metadata_json = 4;
+   */
+  metadataJson = "";
+
+  /**
+   * 创建时间（RFC3339）
+   *
+   * @generated from field: string created_at = 5;
+   */
+  createdAt = "";
+
+  /**
+   * 所属集合 ID，为空表示不属于任何集合
+   *
+   * @generated from field: string collection_id = 6;
+   */
+  collectionId = "";
+
+  constructor(data?: PartialMessage<Document>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.Document";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "minio_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "metadata_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Document {
+    return new Document().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Document {
+    return new Document().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Document {
+    return new Document().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Document | PlainMessage<Document> | undefined, b: Document | PlainMessage<Document> | undefined): boolean {
+    return proto3.util.equals(Document, a, b);
+  }
+}
+
+/**
+ * ListDocumentsResponse 文档列表响应
+ *
+ * @generated from message rag.v1.ListDocumentsResponse
+ */
+export class ListDocumentsResponse extends Message<ListDocumentsResponse> {
+  /**
+   * 文档
+   *
+   * @generated from field: repeated rag.v1.Document documents = 1;
+   */
+  documents: Document[] = [];
+
+  /**
+   * 下一页游标，如为空表示没有更多
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor = "";
+
+  constructor(data?: PartialMessage<ListDocumentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ListDocumentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "documents", kind: "message", T: Document, repeated: true },
+    { no: 2, name: "next_cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDocumentsResponse {
+    return new ListDocumentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDocumentsResponse {
+    return new ListDocumentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDocumentsResponse {
+    return new ListDocumentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDocumentsResponse | PlainMessage<ListDocumentsResponse> | undefined, b: ListDocumentsResponse | PlainMessage<ListDocumentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListDocumentsResponse, a, b);
+  }
+}
+
+/**
+ * DeleteDocumentRequest 删除文档请求
+ *
+ * @generated from message rag.v1.DeleteDocumentRequest
+ */
+export class DeleteDocumentRequest extends Message<DeleteDocumentRequest> {
+  /**
+   * 文档 ID
+   *
+   * @generated from field: string document_id = 1;
+   */
+  documentId = "";
+
+  /**
+   * 文档所属集合 ID，非空时文档必须属于该集合
+   *
+   * @generated from field: string collection_id = 2;
+   */
+  collectionId = "";
+
+  constructor(data?: PartialMessage<DeleteDocumentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.DeleteDocumentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "document_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteDocumentRequest {
+    return new DeleteDocumentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteDocumentRequest {
+    return new DeleteDocumentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteDocumentRequest {
+    return new DeleteDocumentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteDocumentRequest | PlainMessage<DeleteDocumentRequest> | undefined, b: DeleteDocumentRequest | PlainMessage<DeleteDocumentRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteDocumentRequest, a, b);
+  }
+}
+
+/**
+ * DeleteDocumentResponse 删除文档响应
+ *
+ * @generated from message rag.v1.DeleteDocumentResponse
+ */
+export class DeleteDocumentResponse extends Message<DeleteDocumentResponse> {
+  /**
+   * 删除是否成功
+   *
+   * @generated from field: bool success = 1;
+   */
+  success = false;
+
+  /**
+   * 结果信息
+   *
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<DeleteDocumentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.DeleteDocumentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteDocumentResponse {
+    return new DeleteDocumentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteDocumentResponse {
+    return new DeleteDocumentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteDocumentResponse {
+    return new DeleteDocumentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteDocumentResponse | PlainMessage<DeleteDocumentResponse> | undefined, b: DeleteDocumentResponse | PlainMessage<DeleteDocumentResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteDocumentResponse, a, b);
+  }
+}
+
+/**
+ * CollectionSettings 集合级配置覆盖，零值字段使用服务端配置
+ *
+ * @generated from message rag.v1.CollectionSettings
+ */
+export class CollectionSettings extends Message<CollectionSettings> {
+  /**
+   * 分块最大长度
+   *
+   * @generated from field: int32 max_chunk_size = 1;
+   */
+  maxChunkSize = 0;
+
+  /**
+   * 分块最小长度
+   *
+   * @generated from field: int32 min_chunk_size = 2;
+   */
+  minChunkSize = 0;
+
+  /**
+   * 分块重叠长度
+   *
+   * @generated from field: int32 overlap_size = 3;
+   */
+  overlapSize = 0;
+
+  /**
+   * 向量模型，输出维度必须与服务端向量表一致
+   *
+   * @generated from field: string embedding_model = 4;
+   */
+  embeddingModel = "";
+
+  /**
+   * 替换上下文总结的系统提示词
+   *
+   * @generated from field: string system_prompt = 5;
+   */
+  systemPrompt = "";
+
+  constructor(data?: PartialMessage<CollectionSettings>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.CollectionSettings";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_chunk_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "min_chunk_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "overlap_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "embedding_model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "system_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CollectionSettings {
+    return new CollectionSettings().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CollectionSettings {
+    return new CollectionSettings().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CollectionSettings {
+    return new CollectionSettings().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CollectionSettings | PlainMessage<CollectionSettings> | undefined, b: CollectionSettings | PlainMessage<CollectionSettings> | undefined): boolean {
+    return proto3.util.equals(CollectionSettings, a, b);
+  }
+}
+
+/**
+ * Collection 集合（知识库）视图
+ *
+ * @generated from message rag.v1.Collection
+ */
+export class Collection extends Message<Collection> {
+  /**
+   * 集合 ID
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * 集合名称
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * 集合描述
+   *
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * 集合配置
+   *
+   * @generated from field: rag.v1.CollectionSettings settings = 4;
+   */
+  settings?: CollectionSettings;
+
+  /**
+   * 创建时间（RFC3339）
+   *
+   * @generated from field: string created_at = 5;
+   */
+  createdAt = "";
+
+  /**
+   * 文档数量
+   *
+   * @generated from field: int64 document_count = 6;
+   */
+  documentCount = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Collection>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.Collection";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "settings", kind: "message", T: CollectionSettings },
+    { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "document_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Collection {
+    return new Collection().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Collection {
+    return new Collection().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Collection {
+    return new Collection().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Collection | PlainMessage<Collection> | undefined, b: Collection | PlainMessage<Collection> | undefined): boolean {
+    return proto3.util.equals(Collection, a, b);
+  }
+}
+
+/**
+ * CreateCollectionRequest 创建集合请求
+ *
+ * @generated from message rag.v1.CreateCollectionRequest
+ */
+export class CreateCollectionRequest extends Message<CreateCollectionRequest> {
+  /**
+   * 集合名称，全局唯一
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * 集合描述
+   *
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  /**
+   * 集合配置
+   *
+   * @generated from field: rag.v1.CollectionSettings settings = 3;
+   */
+  settings?: CollectionSettings;
+
+  constructor(data?: PartialMessage<CreateCollectionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.CreateCollectionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "settings", kind: "message", T: CollectionSettings },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateCollectionRequest {
+    return new CreateCollectionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateCollectionRequest {
+    return new CreateCollectionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateCollectionRequest {
+    return new CreateCollectionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateCollectionRequest | PlainMessage<CreateCollectionRequest> | undefined, b: CreateCollectionRequest | PlainMessage<CreateCollectionRequest> | undefined): boolean {
+    return proto3.util.equals(CreateCollectionRequest, a, b);
+  }
+}
+
+/**
+ * CreateCollectionResponse 创建集合响应
+ *
+ * @generated from message rag.v1.CreateCollectionResponse
+ */
+export class CreateCollectionResponse extends Message<CreateCollectionResponse> {
+  /**
+   * 创建的集合
+   *
+   * @generated from field: rag.v1.Collection collection = 1;
+   */
+  collection?: Collection;
+
+  constructor(data?: PartialMessage<CreateCollectionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.CreateCollectionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "collection", kind: "message", T: Collection },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateCollectionResponse {
+    return new CreateCollectionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateCollectionResponse {
+    return new CreateCollectionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateCollectionResponse {
+    return new CreateCollectionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateCollectionResponse | PlainMessage<CreateCollectionResponse> | undefined, b: CreateCollectionResponse | PlainMessage<CreateCollectionResponse> | undefined): boolean {
+    return proto3.util.equals(CreateCollectionResponse, a, b);
+  }
+}
+
+/**
+ * ListCollectionsRequest 集合列表请求
+ *
+ * @generated from message rag.v1.ListCollectionsRequest
+ */
+export class ListCollectionsRequest extends Message<ListCollectionsRequest> {
+  constructor(data?: PartialMessage<ListCollectionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ListCollectionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCollectionsRequest {
+    return new ListCollectionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCollectionsRequest {
+    return new ListCollectionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCollectionsRequest {
+    return new ListCollectionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCollectionsRequest | PlainMessage<ListCollectionsRequest> | undefined, b: ListCollectionsRequest | PlainMessage<ListCollectionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListCollectionsRequest, a, b);
+  }
+}
+
+/**
+ * ListCollectionsResponse 集合列表响应
+ *
+ * @generated from message rag.v1.ListCollectionsResponse
+ */
+export class ListCollectionsResponse extends Message<ListCollectionsResponse> {
+  /**
+   * 按名称排序的集合
+   *
+   * @generated from field: repeated rag.v1.Collection collections = 1;
+   */
+  collections: Collection[] = [];
+
+  constructor(data?: PartialMessage<ListCollectionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ListCollectionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "collections", kind: "message", T: Collection, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCollectionsResponse {
+    return new ListCollectionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCollectionsResponse {
+    return new ListCollectionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCollectionsResponse {
+    return new ListCollectionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCollectionsResponse | PlainMessage<ListCollectionsResponse> | undefined, b: ListCollectionsResponse | PlainMessage<ListCollectionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListCollectionsResponse, a, b);
+  }
+}
+
+/**
+ * DeleteCollectionRequest 删除集合请求
+ *
+ * @generated from message rag.v1.DeleteCollectionRequest
+ */
+export class DeleteCollectionRequest extends Message<DeleteCollectionRequest> {
+  /**
+   * 集合 ID
+   *
+   * @generated from field: string collection_id = 1;
+   */
+  collectionId = "";
+
+  constructor(data?: PartialMessage<DeleteCollectionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.DeleteCollectionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "collection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteCollectionRequest {
+    return new DeleteCollectionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteCollectionRequest {
+    return new DeleteCollectionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteCollectionRequest {
+    return new DeleteCollectionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteCollectionRequest | PlainMessage<DeleteCollectionRequest> | undefined, b: DeleteCollectionRequest | PlainMessage<DeleteCollectionRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteCollectionRequest, a, b);
+  }
+}
+
+/**
+ * DeleteCollectionResponse 删除集合响应
+ *
+ * @generated from message rag.v1.DeleteCollectionResponse
+ */
+export class DeleteCollectionResponse extends Message<DeleteCollectionResponse> {
+  /**
+   * 删除是否成功
+   *
+   * @generated from field: bool success = 1;
+   */
+  success = false;
+
+  /**
+   * 结果信息
+   *
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<DeleteCollectionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.DeleteCollectionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteCollectionResponse {
+    return new DeleteCollectionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteCollectionResponse {
+    return new DeleteCollectionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteCollectionResponse {
+    return new DeleteCollectionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteCollectionResponse | PlainMessage<DeleteCollectionResponse> | undefined, b: DeleteCollectionResponse | PlainMessage<DeleteCollectionResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteCollectionResponse, a, b);
+  }
+}
+
+/**
+ * ReindexJob 重建索引任务视图
+ *
+ * @generated from message rag.v1.ReindexJob
+ */
+export class ReindexJob extends Message<ReindexJob> {
+  /**
+   * 任务 ID
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * 任务状态
+   *
+   * @generated from field: rag.v1.ReindexJobStatus status = 2;
+   */
+  status = ReindexJobStatus.UNSPECIFIED;
+
+  /**
+   * 重建方式
+   *
+   * @generated from field: rag.v1.ReindexMode mode = 3;
+   */
+  mode = ReindexMode.UNSPECIFIED;
+
+  /**
+   * 新向量模型
+   *
+   * @generated from field: string embedding_model = 4;
+   */
+  embeddingModel = "";
+
+  /**
+   * 新向量维度
+   *
+   * @generated from field: int32 dimensions = 5;
+   */
+  dimensions = 0;
+
+  /**
+   * 原表集
+   *
+   * @generated from field: string source_table_set = 6;
+   */
+  sourceTableSet = "";
+
+  /**
+   * 新表集
+   *
+   * @generated from field: string target_table_set = 7;
+   */
+  targetTableSet = "";
+
+  /**
+   * 完成后是否自动切换
+   *
+   * @generated from field: bool auto_cutover = 8;
+   */
+  autoCutover = false;
+
+  /**
+   * 原表集文档总数
+   *
+   * @generated from field: int32 total_documents = 9;
+   */
+  totalDocuments = 0;
+
+  /**
+   * 已写入新表集的文档数
+   *
+   * @generated from field: int32 processed_documents = 10;
+   */
+  processedDocuments = 0;
+
+  /**
+   * 已写入新表集的分块数
+   *
+   * @generated from field: int32 processed_chunks = 11;
+   */
+  processedChunks = 0;
+
+  /**
+   * 失败原因
+   *
+   * @generated from field: string error = 12;
+   */
+  error = "";
+
+  /**
+   * 创建时间（RFC3339）
+   *
+   * @generated from field: string created_at = 13;
+   */
+  createdAt = "";
+
+  /**
+   * 最近更新时间（RFC3339）
+   *
+   * @generated from field: string updated_at = 14;
+   */
+  updatedAt = "";
+
+  /**
+   * 完成时间（RFC3339），未完成时为空
+   *
+   * @generated from field: string finished_at = 15;
+   */
+  finishedAt = "";
+
+  constructor(data?: PartialMessage<ReindexJob>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ReindexJob";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(ReindexJobStatus) },
+    { no: 3, name: "mode", kind: "enum", T: proto3.getEnumType(ReindexMode) },
+    { no: 4, name: "embedding_model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "dimensions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "source_table_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "target_table_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "auto_cutover", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "total_documents", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "processed_documents", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "processed_chunks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "finished_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReindexJob {
+    return new ReindexJob().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReindexJob {
+    return new ReindexJob().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReindexJob {
+    return new ReindexJob().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReindexJob | PlainMessage<ReindexJob> | undefined, b: ReindexJob | PlainMessage<ReindexJob> | undefined): boolean {
+    return proto3.util.equals(ReindexJob, a, b);
+  }
+}
+
+/**
+ * StartReindexRequest 启动重建索引请求
+ *
+ * @generated from message rag.v1.StartReindexRequest
+ */
+export class StartReindexRequest extends Message<StartReindexRequest> {
+  /**
+   * 新向量模型，为空时使用服务端配置的模型
+   *
+   * @generated from field: string embedding_model = 1;
+   */
+  embeddingModel = "";
+
+  /**
+   * 重建方式
+   *
+   * @generated from field: rag.v1.ReindexMode mode = 2;
+   */
+  mode = ReindexMode.UNSPECIFIED;
+
+  /**
+   * 写入完成后自动切换到新表集
+   *
+   * @generated from field: bool auto_cutover = 3;
+   */
+  autoCutover = false;
+
+  /**
+   * 新向量维度，须为模型支持的维度；为 0 时配置的模型使用
+   * services.embedding.dimensions，其他模型使用原生维度
+   *
+   * @generated from field: int32 dimensions = 4;
+   */
+  dimensions = 0;
+
+  constructor(data?: PartialMessage<StartReindexRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.StartReindexRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "embedding_model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "mode", kind: "enum", T: proto3.getEnumType(ReindexMode) },
+    { no: 3, name: "auto_cutover", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "dimensions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartReindexRequest {
+    return new StartReindexRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartReindexRequest {
+    return new StartReindexRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartReindexRequest {
+    return new StartReindexRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartReindexRequest | PlainMessage<StartReindexRequest> | undefined, b: StartReindexRequest | PlainMessage<StartReindexRequest> | undefined): boolean {
+    return proto3.util.equals(StartReindexRequest, a, b);
+  }
+}
+
+/**
+ * StartReindexResponse 启动重建索引响应
+ *
+ * @generated from message rag.v1.StartReindexResponse
+ */
+export class StartReindexResponse extends Message<StartReindexResponse> {
+  /**
+   * 创建的任务
+   *
+   * @generated from field: rag.v1.ReindexJob job = 1;
+   */
+  job?: ReindexJob;
 
-  constructor(data?: PartialMessage<PreUploadResponse>) {
+  constructor(data?: PartialMessage<StartReindexResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.PreUploadResponse";
+  static readonly typeName = "rag.v1.StartReindexResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "upload_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "file_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "expires_in", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 1, name: "job", kind: "message", T: ReindexJob },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreUploadResponse {
-    return new PreUploadResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartReindexResponse {
+    return new StartReindexResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreUploadResponse {
-    return new PreUploadResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartReindexResponse {
+    return new StartReindexResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreUploadResponse {
-    return new PreUploadResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartReindexResponse {
+    return new StartReindexResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PreUploadResponse | PlainMessage<PreUploadResponse> | undefined, b: PreUploadResponse | PlainMessage<PreUploadResponse> | undefined): boolean {
-    return proto3.util.equals(PreUploadResponse, a, b);
+  static equals(a: StartReindexResponse | PlainMessage<StartReindexResponse> | undefined, b: StartReindexResponse | PlainMessage<StartReindexResponse> | undefined): boolean {
+    return proto3.util.equals(StartReindexResponse, a, b);
   }
 }
 
 /**
- * 上传PDF请求
+ * GetReindexJobRequest 查询重建索引任务请求
  *
- * @generated from message rag.v1.UploadPdfRequest
+ * @generated from message rag.v1.GetReindexJobRequest
  */
-export class UploadPdfRequest extends Message<UploadPdfRequest> {
-  /**
-   * 文件键不能为空
-   *
-   * @generated from field: string file_key = 1;
-   */
-  fileKey = "";
-
+export class GetReindexJobRequest extends Message<GetReindexJobRequest> {
   /**
-   * 文件名不能为空且必须是PDF文件
+   * 任务 ID
    *
-   * @generated from field: string filename = 2;
+   * @generated from field: string job_id = 1;
    */
-  filename = "";
+  jobId = "";
 
-  constructor(data?: PartialMessage<UploadPdfRequest>) {
+  constructor(data?: PartialMessage<GetReindexJobRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.UploadPdfRequest";
+  static readonly typeName = "rag.v1.GetReindexJobRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "file_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "filename", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadPdfRequest {
-    return new UploadPdfRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReindexJobRequest {
+    return new GetReindexJobRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadPdfRequest {
-    return new UploadPdfRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReindexJobRequest {
+    return new GetReindexJobRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadPdfRequest {
-    return new UploadPdfRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReindexJobRequest {
+    return new GetReindexJobRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UploadPdfRequest | PlainMessage<UploadPdfRequest> | undefined, b: UploadPdfRequest | PlainMessage<UploadPdfRequest> | undefined): boolean {
-    return proto3.util.equals(UploadPdfRequest, a, b);
+  static equals(a: GetReindexJobRequest | PlainMessage<GetReindexJobRequest> | undefined, b: GetReindexJobRequest | PlainMessage<GetReindexJobRequest> | undefined): boolean {
+    return proto3.util.equals(GetReindexJobRequest, a, b);
   }
 }
 
 /**
- * 上传PDF响应
+ * GetReindexJobResponse 查询重建索引任务响应
  *
- * @generated from message rag.v1.UploadPdfResponse
+ * @generated from message rag.v1.GetReindexJobResponse
  */
-export class UploadPdfResponse extends Message<UploadPdfResponse> {
+export class GetReindexJobResponse extends Message<GetReindexJobResponse> {
   /**
-   * 处理是否成功
+   * 任务
    *
-   * @generated from field: bool success = 1;
+   * @generated from field: rag.v1.ReindexJob job = 1;
    */
-  success = false;
+  job?: ReindexJob;
 
-  /**
-   * 处理结果消息
-   *
-   * @generated from field: string message = 2;
-   */
-  message = "";
+  constructor(data?: PartialMessage<GetReindexJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.GetReindexJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job", kind: "message", T: ReindexJob },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetReindexJobResponse {
+    return new GetReindexJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetReindexJobResponse {
+    return new GetReindexJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetReindexJobResponse {
+    return new GetReindexJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetReindexJobResponse | PlainMessage<GetReindexJobResponse> | undefined, b: GetReindexJobResponse | PlainMessage<GetReindexJobResponse> | undefined): boolean {
+    return proto3.util.equals(GetReindexJobResponse, a, b);
+  }
+}
 
+/**
+ * CutoverReindexRequest 切换表集请求
+ *
+ * @generated from message rag.v1.CutoverReindexRequest
+ */
+export class CutoverReindexRequest extends Message<CutoverReindexRequest> {
   /**
-   * 文档唯一标识ID
+   * 状态为 READY 的任务 ID
    *
-   * @generated from field: string document_id = 3;
+   * @generated from field: string job_id = 1;
    */
-  documentId = "";
+  jobId = "";
 
-  constructor(data?: PartialMessage<UploadPdfResponse>) {
+  constructor(data?: PartialMessage<CutoverReindexRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.UploadPdfResponse";
+  static readonly typeName = "rag.v1.CutoverReindexRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "document_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadPdfResponse {
-    return new UploadPdfResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CutoverReindexRequest {
+    return new CutoverReindexRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadPdfResponse {
-    return new UploadPdfResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CutoverReindexRequest {
+    return new CutoverReindexRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadPdfResponse {
-    return new UploadPdfResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CutoverReindexRequest {
+    return new CutoverReindexRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UploadPdfResponse | PlainMessage<UploadPdfResponse> | undefined, b: UploadPdfResponse | PlainMessage<UploadPdfResponse> | undefined): boolean {
-    return proto3.util.equals(UploadPdfResponse, a, b);
+  static equals(a: CutoverReindexRequest | PlainMessage<CutoverReindexRequest> | undefined, b: CutoverReindexRequest | PlainMessage<CutoverReindexRequest> | undefined): boolean {
+    return proto3.util.equals(CutoverReindexRequest, a, b);
   }
 }
 
 /**
- * 获取上下文请求
+ * CutoverReindexResponse 切换表集响应
  *
- * @generated from message rag.v1.GetContextRequest
+ * @generated from message rag.v1.CutoverReindexResponse
  */
-export class GetContextRequest extends Message<GetContextRequest> {
+export class CutoverReindexResponse extends Message<CutoverReindexResponse> {
   /**
-   * 查询字符串不能为空且长度限制
+   * 切换后的任务
    *
-   * @generated from field: string query = 1;
+   * @generated from field: rag.v1.ReindexJob job = 1;
    */
-  query = "";
+  job?: ReindexJob;
 
-  constructor(data?: PartialMessage<GetContextRequest>) {
+  constructor(data?: PartialMessage<CutoverReindexResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.GetContextRequest";
+  static readonly typeName = "rag.v1.CutoverReindexResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "job", kind: "message", T: ReindexJob },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetContextRequest {
-    return new GetContextRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CutoverReindexResponse {
+    return new CutoverReindexResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetContextRequest {
-    return new GetContextRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CutoverReindexResponse {
+    return new CutoverReindexResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetContextRequest {
-    return new GetContextRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CutoverReindexResponse {
+    return new CutoverReindexResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetContextRequest | PlainMessage<GetContextRequest> | undefined, b: GetContextRequest | PlainMessage<GetContextRequest> | undefined): boolean {
-    return proto3.util.equals(GetContextRequest, a, b);
+  static equals(a: CutoverReindexResponse | PlainMessage<CutoverReindexResponse> | undefined, b: CutoverReindexResponse | PlainMessage<CutoverReindexResponse> | undefined): boolean {
+    return proto3.util.equals(CutoverReindexResponse, a, b);
   }
 }
 
 /**
- * 获取上下文响应
+ * RollbackReindexRequest 回滚表集请求
  *
- * @generated from message rag.v1.GetContextResponse
+ * @generated from message rag.v1.RollbackReindexRequest
  */
-export class GetContextResponse extends Message<GetContextResponse> {
+export class RollbackReindexRequest extends Message<RollbackReindexRequest> {
   /**
-   * 检索到的相关上下文内容
+   * 状态为 ACTIVE 的任务 ID
    *
-   * @generated from field: string context = 1;
-   */
-  context = "";
-
-  /**
-   * 关键词
-   *
-   * @generated from field: repeated string keywords = 2;
+   * @generated from field: string job_id = 1;
    */
-  keywords: string[] = [];
+  jobId = "";
 
-  constructor(data?: PartialMessage<GetContextResponse>) {
+  constructor(data?: PartialMessage<RollbackReindexRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.GetContextResponse";
+  static readonly typeName = "rag.v1.RollbackReindexRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "context", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "keywords", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetContextResponse {
-    return new GetContextResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackReindexRequest {
+    return new RollbackReindexRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetContextResponse {
-    return new GetContextResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackReindexRequest {
+    return new RollbackReindexRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetContextResponse {
-    return new GetContextResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackReindexRequest {
+    return new RollbackReindexRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetContextResponse | PlainMessage<GetContextResponse> | undefined, b: GetContextResponse | PlainMessage<GetContextResponse> | undefined): boolean {
-    return proto3.util.equals(GetContextResponse, a, b);
+  static equals(a: RollbackReindexRequest | PlainMessage<RollbackReindexRequest> | undefined, b: RollbackReindexRequest | PlainMessage<RollbackReindexRequest> | undefined): boolean {
+    return proto3.util.equals(RollbackReindexRequest, a, b);
   }
 }
 
 /**
- * ListDocumentsRequest 文档列表请求（游标分页）
+ * RollbackReindexResponse 回滚表集响应
  *
- * @generated from message rag.v1.ListDocumentsRequest
+ * @generated from message rag.v1.RollbackReindexResponse
  */
-export class ListDocumentsRequest extends Message<ListDocumentsRequest> {
+export class RollbackReindexResponse extends Message<RollbackReindexResponse> {
   /**
-   * 页面大小，默认 50，最大 200
-   *
-   * @generated from field: int32 page_size = 1;
-   */
-  pageSize = 0;
-
-  /**
-   * 游标（上一页返回的 next_cursor）
+   * 回滚后的任务
    *
-   * @generated from field: string cursor = 2;
+   * @generated from field: rag.v1.ReindexJob job = 1;
    */
-  cursor = "";
+  job?: ReindexJob;
 
-  constructor(data?: PartialMessage<ListDocumentsRequest>) {
+  constructor(data?: PartialMessage<RollbackReindexResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.ListDocumentsRequest";
+  static readonly typeName = "rag.v1.RollbackReindexResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "job", kind: "message", T: ReindexJob },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDocumentsRequest {
-    return new ListDocumentsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackReindexResponse {
+    return new RollbackReindexResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDocumentsRequest {
-    return new ListDocumentsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackReindexResponse {
+    return new RollbackReindexResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDocumentsRequest {
-    return new ListDocumentsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackReindexResponse {
+    return new RollbackReindexResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListDocumentsRequest | PlainMessage<ListDocumentsRequest> | undefined, b: ListDocumentsRequest | PlainMessage<ListDocumentsRequest> | undefined): boolean {
-    return proto3.util.equals(ListDocumentsRequest, a, b);
+  static equals(a: RollbackReindexResponse | PlainMessage<RollbackReindexResponse> | undefined, b: RollbackReindexResponse | PlainMessage<RollbackReindexResponse> | undefined): boolean {
+    return proto3.util.equals(RollbackReindexResponse, a, b);
   }
 }
 
 /**
- * Document 文档视图
+ * TableSet 由同一向量模型生成的一套文档、分块与集合表
  *
- * @generated from message rag.v1.Document
+ * @generated from message rag.v1.TableSet
  */
-export class Document extends Message<Document> {
+export class TableSet extends Message<TableSet> {
   /**
-   * 文档 ID
+   * 表集名称，表名为 document_<name>、document_chunk_<name>、collection_<name>
    *
-   * @generated from field: string id = 1;
+   * @generated from field: string name = 1;
    */
-  id = "";
+  name = "";
 
   /**
-   * 文档标题
+   * 生成向量的模型
    *
-   * @generated from field: string title = 2;
+   * @generated from field: string embedding_model = 2;
    */
-  title = "";
+  embeddingModel = "";
 
   /**
-   * 存储键
+   * 向量维度
    *
-   * @generated from field: string minio_key = 3;
+   * @generated from field: int32 dimensions = 3;
    */
-  minioKey = "";
+  dimensions = 0;
 
   /**
-   * 元数据 JSON
+   * 是否为当前生效的表集
    *
-   * @generated from field: string metadata_json = 4;
+   * @generated from field: bool active = 4;
    */
-  metadataJson = "";
+  active = false;
 
   /**
    * 创建时间（RFC3339）
@@ -390,134 +3983,167 @@ export class Document extends Message<Document> {
    */
   createdAt = "";
 
-  constructor(data?: PartialMessage<Document>) {
+  /**
+   * 最近一次生效时间（RFC3339），从未生效时为空
+   *
+   * @generated from field: string activated_at = 6;
+   */
+  activatedAt = "";
+
+  constructor(data?: PartialMessage<TableSet>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.Document";
+  static readonly typeName = "rag.v1.TableSet";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "minio_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "metadata_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "embedding_model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "dimensions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "activated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Document {
-    return new Document().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TableSet {
+    return new TableSet().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Document {
-    return new Document().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TableSet {
+    return new TableSet().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Document {
-    return new Document().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TableSet {
+    return new TableSet().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Document | PlainMessage<Document> | undefined, b: Document | PlainMessage<Document> | undefined): boolean {
-    return proto3.util.equals(Document, a, b);
+  static equals(a: TableSet | PlainMessage<TableSet> | undefined, b: TableSet | PlainMessage<TableSet> | undefined): boolean {
+    return proto3.util.equals(TableSet, a, b);
   }
 }
 
 /**
- * ListDocumentsResponse 文档列表响应
+ * ListTableSetsRequest 表集列表请求
  *
- * @generated from message rag.v1.ListDocumentsResponse
+ * @generated from message rag.v1.ListTableSetsRequest
  */
-export class ListDocumentsResponse extends Message<ListDocumentsResponse> {
-  /**
-   * 文档
-   *
-   * @generated from field: repeated rag.v1.Document documents = 1;
-   */
-  documents: Document[] = [];
+export class ListTableSetsRequest extends Message<ListTableSetsRequest> {
+  constructor(data?: PartialMessage<ListTableSetsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rag.v1.ListTableSetsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTableSetsRequest {
+    return new ListTableSetsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTableSetsRequest {
+    return new ListTableSetsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTableSetsRequest {
+    return new ListTableSetsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTableSetsRequest | PlainMessage<ListTableSetsRequest> | undefined, b: ListTableSetsRequest | PlainMessage<ListTableSetsRequest> | undefined): boolean {
+    return proto3.util.equals(ListTableSetsRequest, a, b);
+  }
+}
 
+/**
+ * ListTableSetsResponse 表集列表响应
+ *
+ * @generated from message rag.v1.ListTableSetsResponse
+ */
+export class ListTableSetsResponse extends Message<ListTableSetsResponse> {
   /**
-   * 下一页游标，如为空表示没有更多
+   * 按创建时间排序的表集
    *
-   * @generated from field: string next_cursor = 2;
+   * @generated from field: repeated rag.v1.TableSet table_sets = 1;
    */
-  nextCursor = "";
+  tableSets: TableSet[] = [];
 
-  constructor(data?: PartialMessage<ListDocumentsResponse>) {
+  constructor(data?: PartialMessage<ListTableSetsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.ListDocumentsResponse";
+  static readonly typeName = "rag.v1.ListTableSetsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "documents", kind: "message", T: Document, repeated: true },
-    { no: 2, name: "next_cursor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "table_sets", kind: "message", T: TableSet, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDocumentsResponse {
-    return new ListDocumentsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTableSetsResponse {
+    return new ListTableSetsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDocumentsResponse {
-    return new ListDocumentsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTableSetsResponse {
+    return new ListTableSetsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDocumentsResponse {
-    return new ListDocumentsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTableSetsResponse {
+    return new ListTableSetsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListDocumentsResponse | PlainMessage<ListDocumentsResponse> | undefined, b: ListDocumentsResponse | PlainMessage<ListDocumentsResponse> | undefined): boolean {
-    return proto3.util.equals(ListDocumentsResponse, a, b);
+  static equals(a: ListTableSetsResponse | PlainMessage<ListTableSetsResponse> | undefined, b: ListTableSetsResponse | PlainMessage<ListTableSetsResponse> | undefined): boolean {
+    return proto3.util.equals(ListTableSetsResponse, a, b);
   }
 }
 
 /**
- * DeleteDocumentRequest 删除文档请求
+ * DeleteTableSetRequest 删除表集请求
  *
- * @generated from message rag.v1.DeleteDocumentRequest
+ * @generated from message rag.v1.DeleteTableSetRequest
  */
-export class DeleteDocumentRequest extends Message<DeleteDocumentRequest> {
+export class DeleteTableSetRequest extends Message<DeleteTableSetRequest> {
   /**
-   * 文档 ID
+   * 表集名称，不能是生效中的表集
    *
-   * @generated from field: string document_id = 1;
+   * @generated from field: string name = 1;
    */
-  documentId = "";
+  name = "";
 
-  constructor(data?: PartialMessage<DeleteDocumentRequest>) {
+  constructor(data?: PartialMessage<DeleteTableSetRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.DeleteDocumentRequest";
+  static readonly typeName = "rag.v1.DeleteTableSetRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "document_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteDocumentRequest {
-    return new DeleteDocumentRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTableSetRequest {
+    return new DeleteTableSetRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteDocumentRequest {
-    return new DeleteDocumentRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTableSetRequest {
+    return new DeleteTableSetRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteDocumentRequest {
-    return new DeleteDocumentRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTableSetRequest {
+    return new DeleteTableSetRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteDocumentRequest | PlainMessage<DeleteDocumentRequest> | undefined, b: DeleteDocumentRequest | PlainMessage<DeleteDocumentRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteDocumentRequest, a, b);
+  static equals(a: DeleteTableSetRequest | PlainMessage<DeleteTableSetRequest> | undefined, b: DeleteTableSetRequest | PlainMessage<DeleteTableSetRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteTableSetRequest, a, b);
   }
 }
 
 /**
- * DeleteDocumentResponse 删除文档响应
+ * DeleteTableSetResponse 删除表集响应
  *
- * @generated from message rag.v1.DeleteDocumentResponse
+ * @generated from message rag.v1.DeleteTableSetResponse
  */
-export class DeleteDocumentResponse extends Message<DeleteDocumentResponse> {
+export class DeleteTableSetResponse extends Message<DeleteTableSetResponse> {
   /**
    * 删除是否成功
    *
@@ -532,32 +4158,32 @@ export class DeleteDocumentResponse extends Message<DeleteDocumentResponse> {
    */
   message = "";
 
-  constructor(data?: PartialMessage<DeleteDocumentResponse>) {
+  constructor(data?: PartialMessage<DeleteTableSetResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rag.v1.DeleteDocumentResponse";
+  static readonly typeName = "rag.v1.DeleteTableSetResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteDocumentResponse {
-    return new DeleteDocumentResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTableSetResponse {
+    return new DeleteTableSetResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteDocumentResponse {
-    return new DeleteDocumentResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTableSetResponse {
+    return new DeleteTableSetResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteDocumentResponse {
-    return new DeleteDocumentResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTableSetResponse {
+    return new DeleteTableSetResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteDocumentResponse | PlainMessage<DeleteDocumentResponse> | undefined, b: DeleteDocumentResponse | PlainMessage<DeleteDocumentResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteDocumentResponse, a, b);
+  static equals(a: DeleteTableSetResponse | PlainMessage<DeleteTableSetResponse> | undefined, b: DeleteTableSetResponse | PlainMessage<DeleteTableSetResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteTableSetResponse, a, b);
   }
 }
 